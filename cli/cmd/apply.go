@@ -0,0 +1,351 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/manifest"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply -f FILENAME",
+	Short: "Apply a manifest to declaratively create or update resources",
+	Long: `Apply reads one or more YAML/JSON documents and creates or updates
+the resources they describe. A host whose name is already registered is
+skipped, and a service that already exists on the target host is updated
+in place; otherwise the resource is created. Secrets and configs are
+always created, and field values are not compared against the live
+resource.
+
+Examples:
+  # Apply a single manifest
+  docker-swarm-ctl apply -f service.yaml
+
+  # Preview the changes apply would make without sending them
+  docker-swarm-ctl apply -f stack.yaml --dry-run=client`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, _ := cmd.Flags().GetString("file")
+		if file == "" {
+			return fmt.Errorf("-f/--file is required")
+		}
+
+		hostOverride, _ := cmd.Flags().GetString("host")
+		dryRun, _ := cmd.Flags().GetString("dry-run")
+		prune, _ := cmd.Flags().GetBool("prune")
+
+		if dryRun != "" && dryRun != "client" && dryRun != "server" {
+			return fmt.Errorf("invalid --dry-run value %q: must be \"client\" or \"server\"", dryRun)
+		}
+
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		data, err := readManifestFile(file)
+		if err != nil {
+			return err
+		}
+
+		docs, err := manifest.DecodeStream(data)
+		if err != nil {
+			return err
+		}
+
+		a := &applier{hostOverride: hostOverride, dryRun: dryRun, prune: prune}
+		for _, doc := range docs {
+			if err := a.apply(doc); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func readManifestFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// applier applies decoded manifest documents against the live API,
+// resolving a single effective host for resources that need one.
+type applier struct {
+	hostOverride string
+	dryRun       string
+	prune        bool
+}
+
+func (a *applier) resolveHost(specHost string) (string, error) {
+	if a.hostOverride != "" {
+		return a.hostOverride, nil
+	}
+	if specHost != "" {
+		return specHost, nil
+	}
+	return "", fmt.Errorf("no host specified: set --host or spec.host in the manifest")
+}
+
+func (a *applier) apply(doc manifest.Document) error {
+	if a.prune && doc.Kind != manifest.KindStack {
+		output.Warning("--prune has no effect on %s manifests (no bounded resource set to diff against); ignoring", doc.Kind)
+	}
+
+	switch doc.Kind {
+	case manifest.KindHost:
+		var spec manifest.HostSpec
+		if err := manifest.Decode(doc, &spec); err != nil {
+			return err
+		}
+		return a.applyHost(spec)
+	case manifest.KindService:
+		var spec manifest.ServiceSpec
+		if err := manifest.Decode(doc, &spec); err != nil {
+			return err
+		}
+		return a.applyService(spec)
+	case manifest.KindSecret:
+		var spec manifest.SecretSpec
+		if err := manifest.Decode(doc, &spec); err != nil {
+			return err
+		}
+		return a.applySecret(spec)
+	case manifest.KindConfig:
+		var spec manifest.ConfigSpec
+		if err := manifest.Decode(doc, &spec); err != nil {
+			return err
+		}
+		return a.applyConfig(spec)
+	case manifest.KindStack:
+		var spec manifest.StackSpec
+		if err := manifest.Decode(doc, &spec); err != nil {
+			return err
+		}
+		return a.applyStack(spec)
+	default:
+		return fmt.Errorf("unknown manifest kind %q", doc.Kind)
+	}
+}
+
+func (a *applier) applyHost(spec manifest.HostSpec) error {
+	if a.dryRun == "client" {
+		output.Info("(dry-run) would apply host %q", spec.Metadata.Name)
+		return nil
+	}
+
+	hosts, err := apiClient.ListHosts()
+	if err != nil {
+		return err
+	}
+	for _, h := range hosts {
+		if h.DisplayName == spec.Metadata.Name {
+			output.Info("host %q already exists, skipping", spec.Metadata.Name)
+			return nil
+		}
+	}
+
+	if a.dryRun == "server" {
+		output.Info("(dry-run) would create host %q", spec.Metadata.Name)
+		return nil
+	}
+
+	_, err = apiClient.CreateHost(client.HostCreate{
+		DisplayName: spec.Metadata.Name,
+		URL:         spec.Spec.URL,
+		TLSEnabled:  spec.Spec.TLSEnabled,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create host %q: %w", spec.Metadata.Name, err)
+	}
+	output.Success("host %q created", spec.Metadata.Name)
+	return nil
+}
+
+func serviceCreateFromSpec(spec manifest.ServiceSpec) client.ServiceCreate {
+	ports := make([]client.ServicePort, 0, len(spec.Spec.Ports))
+	for _, p := range spec.Spec.Ports {
+		ports = append(ports, client.ServicePort{Published: p.Published, Target: p.Target, Protocol: p.Protocol})
+	}
+
+	registryAuth, err := apiClient.RetrieveAuthTokenFromImage(spec.Spec.Image)
+	if err != nil {
+		output.Warning("failed to resolve registry credentials for %s: %v", spec.Spec.Image, err)
+	}
+
+	return client.ServiceCreate{
+		Name:         spec.Metadata.Name,
+		Image:        spec.Spec.Image,
+		Replicas:     spec.Spec.Replicas,
+		Mode:         spec.Spec.Mode,
+		Env:          spec.Spec.Env,
+		Labels:       spec.Metadata.Labels,
+		Ports:        ports,
+		RegistryAuth: registryAuth,
+	}
+}
+
+func (a *applier) applyService(spec manifest.ServiceSpec) error {
+	hostID, err := a.resolveHost(spec.Spec.Host)
+	if err != nil {
+		return err
+	}
+
+	create := serviceCreateFromSpec(spec)
+
+	if a.dryRun == "client" {
+		output.Info("(dry-run) would apply service %q on host %s", spec.Metadata.Name, hostID)
+		return nil
+	}
+
+	existing, err := apiClient.GetService(hostID, spec.Metadata.Name)
+	if err == nil {
+		if a.dryRun == "server" {
+			output.Info("(dry-run) would update service %q on host %s", spec.Metadata.Name, hostID)
+			return nil
+		}
+		if _, err := apiClient.UpdateService(hostID, existing.ID, create); err != nil {
+			return fmt.Errorf("failed to update service %q: %w", spec.Metadata.Name, err)
+		}
+		output.Success("service %q updated", spec.Metadata.Name)
+		return nil
+	}
+
+	if a.dryRun == "server" {
+		output.Info("(dry-run) would create service %q on host %s", spec.Metadata.Name, hostID)
+		return nil
+	}
+
+	if _, err := apiClient.CreateService(hostID, create); err != nil {
+		return fmt.Errorf("failed to create service %q: %w", spec.Metadata.Name, err)
+	}
+	output.Success("service %q created", spec.Metadata.Name)
+	return nil
+}
+
+func (a *applier) applySecret(spec manifest.SecretSpec) error {
+	hostID, err := a.resolveHost(spec.Spec.Host)
+	if err != nil {
+		return err
+	}
+	if a.dryRun != "" {
+		output.Info("(dry-run) would apply secret %q on host %s", spec.Metadata.Name, hostID)
+		return nil
+	}
+	_, err = apiClient.CreateSecret(hostID, client.SecretCreate{
+		Name:   spec.Metadata.Name,
+		Data:   spec.Spec.Data,
+		Labels: spec.Metadata.Labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create secret %q: %w", spec.Metadata.Name, err)
+	}
+	output.Success("secret %q created", spec.Metadata.Name)
+	return nil
+}
+
+func (a *applier) applyConfig(spec manifest.ConfigSpec) error {
+	hostID, err := a.resolveHost(spec.Spec.Host)
+	if err != nil {
+		return err
+	}
+	if a.dryRun != "" {
+		output.Info("(dry-run) would apply config %q on host %s", spec.Metadata.Name, hostID)
+		return nil
+	}
+	_, err = apiClient.CreateConfig(hostID, client.ConfigCreate{
+		Name:   spec.Metadata.Name,
+		Data:   spec.Spec.Data,
+		Labels: spec.Metadata.Labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create config %q: %w", spec.Metadata.Name, err)
+	}
+	output.Success("config %q created", spec.Metadata.Name)
+	return nil
+}
+
+func (a *applier) applyStack(spec manifest.StackSpec) error {
+	hostID, err := a.resolveHost(spec.Spec.Host)
+	if err != nil {
+		return err
+	}
+
+	namespace := spec.Metadata.Name
+	for _, s := range spec.Spec.Secrets {
+		if s.Spec.Host == "" {
+			s.Spec.Host = hostID
+		}
+		if err := a.applySecret(s); err != nil {
+			return err
+		}
+	}
+	for _, c := range spec.Spec.Configs {
+		if c.Spec.Host == "" {
+			c.Spec.Host = hostID
+		}
+		if err := a.applyConfig(c); err != nil {
+			return err
+		}
+	}
+	desired := make(map[string]bool, len(spec.Spec.Services))
+	for _, svc := range spec.Spec.Services {
+		if svc.Spec.Host == "" {
+			svc.Spec.Host = hostID
+		}
+		if svc.Metadata.Labels == nil {
+			svc.Metadata.Labels = map[string]string{}
+		}
+		svc.Metadata.Labels[stackNamespaceLabel] = namespace
+		desired[svc.Metadata.Name] = true
+		if err := a.applyService(svc); err != nil {
+			return err
+		}
+	}
+
+	if a.prune {
+		return a.pruneStackServices(hostID, namespace, desired)
+	}
+	return nil
+}
+
+// pruneStackServices removes services labeled under namespace that are no
+// longer declared in the manifest - the delete half of apply --prune's
+// create/update/delete diff, scoped to a stack the same way stack rm is.
+func (a *applier) pruneStackServices(hostID, namespace string, desired map[string]bool) error {
+	services, err := stackServices(hostID, namespace)
+	if err != nil {
+		return err
+	}
+	for _, svc := range services {
+		if desired[svc.Name] {
+			continue
+		}
+		if a.dryRun != "" {
+			output.Info("(dry-run) would prune service %q", svc.Name)
+			continue
+		}
+		if err := apiClient.DeleteService(hostID, svc.ID); err != nil {
+			return fmt.Errorf("failed to prune service %q: %w", svc.Name, err)
+		}
+		output.Success("service %q pruned", svc.Name)
+	}
+	return nil
+}
+
+func init() {
+	applyCmd.Flags().StringP("file", "f", "", "Filename containing the manifest to apply")
+	applyCmd.Flags().String("host", "", "Host ID to apply swarm resources to (overrides spec.host)")
+	applyCmd.Flags().Bool("prune", false, "Delete stack services that are no longer present in the manifest (stack manifests only)")
+	applyCmd.Flags().String("dry-run", "", "Preview changes without sending them (client|server)")
+	applyCmd.MarkFlagRequired("file")
+}
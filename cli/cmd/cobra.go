@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// isManagementCommand reports whether cmd is a parent for a resource's own
+// subcommands (e.g. `node`, `service`) rather than a single operation, the
+// same distinction the Docker CLI's help grouping makes.
+func isManagementCommand(cmd *cobra.Command) bool {
+	return cmd.HasAvailableSubCommands()
+}
+
+// hasManagementSubCommands reports whether cmd has at least one child that
+// is itself a management command, i.e. whether a "Management Commands"
+// section is needed in cmd's help output.
+func hasManagementSubCommands(cmd *cobra.Command) bool {
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && isManagementCommand(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// managementSubCommands returns cmd's children that are themselves
+// management commands.
+func managementSubCommands(cmd *cobra.Command) []*cobra.Command {
+	var result []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && isManagementCommand(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// isPluginCommand reports whether cmd shells out to a discovered CLI
+// plugin executable rather than being a built-in command.
+func isPluginCommand(cmd *cobra.Command) bool {
+	return cmd.Annotations[pluginAnnotation] == "true"
+}
+
+// hasPluginSubCommands reports whether cmd has at least one plugin child,
+// i.e. whether a "Plugin Commands" section is needed in cmd's help output.
+func hasPluginSubCommands(cmd *cobra.Command) bool {
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && isPluginCommand(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// pluginSubCommands returns cmd's children that are discovered plugins.
+func pluginSubCommands(cmd *cobra.Command) []*cobra.Command {
+	var result []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && isPluginCommand(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// operationSubCommands returns cmd's children that are plain operations
+// (leaf commands), as opposed to management or plugin commands.
+func operationSubCommands(cmd *cobra.Command) []*cobra.Command {
+	var result []*cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.IsAvailableCommand() && !isManagementCommand(c) && !isPluginCommand(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// usageTemplate mirrors the Docker CLI's grouped help: top-level resource
+// commands (node, service, ...) are listed under "Management Commands",
+// discovered plugin executables under "Plugin Commands", and everything
+// else under "Commands".
+const usageTemplate = `Usage:{{if .Runnable}}
+  {{.UseLine}}{{end}}{{if .HasAvailableSubCommands}}
+  {{.CommandPath}} [command]{{end}}{{if gt (len .Aliases) 0}}
+
+Aliases:
+  {{.NameAndAliases}}{{end}}{{if .HasExample}}
+
+Examples:
+{{.Example}}{{end}}{{if .HasAvailableSubCommands}}{{if hasManagementSubCommands .}}
+
+Management Commands:{{range managementSubCommands .}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableSubCommands}}{{if operationSubCommands .}}
+
+Commands:{{range operationSubCommands .}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableSubCommands}}{{if hasPluginSubCommands .}}
+
+Plugin Commands:{{range pluginSubCommands .}}
+  {{rpad .Name .NamePadding }} {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableLocalFlags}}
+
+Flags:
+{{.LocalFlags.FlagUsages | trimTrailingWhitespace}}{{end}}{{if .HasAvailableInheritedFlags}}
+
+Global Flags:
+{{.InheritedFlags.FlagUsages | trimTrailingWhitespace}}{{end}}{{if .HasHelpSubCommands}}
+
+Additional help topics:{{range .Commands}}{{if .IsAdditionalHelpTopicCommand}}
+  {{rpad .CommandPath .CommandPathPadding}} {{.Short}}{{end}}{{end}}{{end}}{{if .HasAvailableSubCommands}}
+
+Use "{{.CommandPath}} [command] --help" for more information about a command.{{end}}
+`
+
+// SetupRootCommand installs the grouped usage template used across the
+// whole command tree, so `--help` on any parent with resource-management
+// children (node, service, secret, config, container, ...) separates them
+// from plain operation subcommands.
+func SetupRootCommand(rootCmd *cobra.Command) {
+	cobra.AddTemplateFunc("hasManagementSubCommands", hasManagementSubCommands)
+	cobra.AddTemplateFunc("managementSubCommands", managementSubCommands)
+	cobra.AddTemplateFunc("operationSubCommands", operationSubCommands)
+	cobra.AddTemplateFunc("hasPluginSubCommands", hasPluginSubCommands)
+	cobra.AddTemplateFunc("pluginSubCommands", pluginSubCommands)
+	rootCmd.SetUsageTemplate(usageTemplate)
+}
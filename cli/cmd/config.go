@@ -71,17 +71,30 @@ var configAddContextCmd = &cobra.Command{
 		apiURL, _ := cmd.Flags().GetString("api-url")
 		username, _ := cmd.Flags().GetString("username")
 		verifySSL, _ := cmd.Flags().GetBool("verify-ssl")
+		dockerHost, _ := cmd.Flags().GetString("docker-host")
+		sshKeyPath, _ := cmd.Flags().GetString("ssh-key")
+		sshKnownHosts, _ := cmd.Flags().GetString("ssh-known-hosts")
 
-		if apiURL == "" {
-			return fmt.Errorf("--api-url is required")
+		ctx := &config.Context{
+			Username:  username,
+			VerifySSL: verifySSL,
+		}
+
+		switch {
+		case dockerHost != "":
+			ctx.Type = config.ContextTypeSSH
+			ctx.DockerHost = dockerHost
+			ctx.SSHKeyPath = sshKeyPath
+			ctx.SSHKnownHosts = sshKnownHosts
+		case apiURL != "":
+			ctx.Type = config.ContextTypeAPI
+			ctx.APIUrl = apiURL
+		default:
+			return fmt.Errorf("either --api-url or --docker-host is required")
 		}
 
 		// Add context
-		cfg.AddContext(name, &config.Context{
-			APIUrl:    apiURL,
-			Username:  username,
-			VerifySSL: verifySSL,
-		})
+		cfg.AddContext(name, ctx)
 
 		// Save configuration
 		configPath := viper.ConfigFileUsed()
@@ -219,5 +232,7 @@ func init() {
 	configAddContextCmd.Flags().String("api-url", "", "API URL for the context")
 	configAddContextCmd.Flags().String("username", "", "Default username for this context")
 	configAddContextCmd.Flags().Bool("verify-ssl", true, "Verify SSL certificates")
-	configAddContextCmd.MarkFlagRequired("api-url")
+	configAddContextCmd.Flags().String("docker-host", "", "Connect directly to a Docker daemon over SSH instead of the REST API (ssh://user@host)")
+	configAddContextCmd.Flags().String("ssh-key", "", "Private key path to use for --docker-host ssh:// connections")
+	configAddContextCmd.Flags().String("ssh-known-hosts", "", "known_hosts path to use for --docker-host ssh:// connections")
 }
\ No newline at end of file
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+// configsCmd is the management-tree equivalent of secretCmd/nodeCmd/serviceCmd
+// for Swarm configs. It is registered as "configs" rather than "config"
+// because "config" is already taken by the pre-existing CLI-settings command
+// (contexts, API URLs, etc. - see config.go); the flat `get/create/delete/
+// inspect config` commands are unaffected by this and remain the primary way
+// to manage Swarm configs.
+var configsCmd = &cobra.Command{
+	Use:     "configs",
+	Aliases: []string{"swarm-configs"},
+	Short:   "Manage Swarm configs",
+}
+
+var configsLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List configs",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		configs, err := apiClient.ListConfigs(hostID)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		return output.NewPrinter(outputFormat).Print(configs)
+	},
+}
+
+var configsCreateCmd = &cobra.Command{
+	Use:   "create CONFIG FILE",
+	Short: "Create a config from a file or STDIN",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		name := args[0]
+		data, err := readManifestFile(args[1])
+		if err != nil {
+			return err
+		}
+
+		config, err := apiClient.CreateConfig(hostID, client.ConfigCreate{
+			Name: name,
+			Data: string(data),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create config %q: %w", name, err)
+		}
+
+		output.Success("config %q created", config.Name)
+		return nil
+	},
+}
+
+var configsInspectCmd = &cobra.Command{
+	Use:   "inspect CONFIG",
+	Short: "Display detailed information on a config",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return inspectCmd.RunE(cmd, []string{"config", args[0]})
+	},
+}
+
+var configsRmCmd = &cobra.Command{
+	Use:     "rm CONFIG [CONFIG...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more configs",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		for _, name := range args {
+			if err := apiClient.DeleteConfig(hostID, name); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("config %q removed", name)
+		}
+		return nil
+	},
+}
+
+func init() {
+	configsCmd.AddCommand(configsLsCmd)
+	configsCmd.AddCommand(configsCreateCmd)
+	configsCmd.AddCommand(configsInspectCmd)
+	configsCmd.AddCommand(configsRmCmd)
+
+	for _, c := range []*cobra.Command{configsLsCmd, configsCreateCmd, configsInspectCmd, configsRmCmd} {
+		c.Flags().String("host", "", "Host ID (required)")
+		c.MarkFlagRequired("host")
+	}
+}
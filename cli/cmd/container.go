@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var containerCmd = &cobra.Command{
+	Use:   "container",
+	Short: "Manage containers",
+}
+
+var containerLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list", "ps"},
+	Short:   "List containers",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return getContainers(cmd, "")
+	},
+}
+
+var containerInspectCmd = &cobra.Command{
+	Use:   "inspect CONTAINER",
+	Short: "Display detailed information on a container",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// No ListContainers/Container type exists in pkg/client yet to
+		// resolve a container by name/ID - left as an honest stub rather
+		// than a silent no-op, matching containerLsCmd/containerRmCmd.
+		return fmt.Errorf("inspecting container %s: not yet implemented", args[0])
+	},
+}
+
+var containerLogsCmd = &cobra.Command{
+	Use:   "logs CONTAINER",
+	Short: "Print the logs of a container",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return logsCmd.RunE(cmd, args)
+	},
+}
+
+var containerExecCmd = &cobra.Command{
+	Use:   "exec CONTAINER COMMAND [ARG...]",
+	Short: "Execute a command in a container",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return execCmd.RunE(cmd, args)
+	},
+}
+
+var containerRmCmd = &cobra.Command{
+	Use:     "rm CONTAINER [CONTAINER...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more containers",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range args {
+			if err := deleteCmd.RunE(cmd, []string{"container", name}); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	containerCmd.AddCommand(containerLsCmd)
+	containerCmd.AddCommand(containerInspectCmd)
+	containerCmd.AddCommand(containerLogsCmd)
+	containerCmd.AddCommand(containerExecCmd)
+	containerCmd.AddCommand(containerRmCmd)
+
+	for _, c := range []*cobra.Command{containerLsCmd, containerInspectCmd, containerLogsCmd, containerExecCmd, containerRmCmd} {
+		c.Flags().String("host", "", "Host ID (required)")
+		c.MarkFlagRequired("host")
+	}
+
+	containerLogsCmd.Flags().BoolP("follow", "f", false, "Follow log output")
+	containerLogsCmd.Flags().Int("tail", 100, "Number of lines to show from the end")
+	containerLogsCmd.Flags().BoolP("timestamps", "t", false, "Show timestamps")
+
+	containerExecCmd.Flags().BoolP("stdin", "i", false, "Attach stdin")
+	containerExecCmd.Flags().BoolP("tty", "t", false, "Allocate a pseudo-TTY")
+	containerExecCmd.Flags().StringArrayP("env", "e", nil, "Set environment variables (KEY=VALUE)")
+	containerExecCmd.Flags().StringP("workdir", "w", "", "Working directory inside the container")
+	containerExecCmd.Flags().StringP("user", "u", "", "Username or UID to run the command as")
+}
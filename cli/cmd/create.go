@@ -4,10 +4,14 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/manifest"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
 )
 
 var createCmd = &cobra.Command{
-	Use:   "create TYPE",
+	Use:   "create TYPE -f FILENAME",
 	Short: "Create a resource",
 	Long: `Create a resource from a file or stdin.
 
@@ -17,32 +21,130 @@ Resource types:
   - secret
   - config
 
+This delegates to the same manifest decoding and apply logic as
+'docker-swarm-ctl apply -f', requiring exactly one document of the
+matching kind.
+
 Examples:
   # Create a host
-  docker-swarm-ctl create host --name docker-1 --url tcp://192.168.1.100:2376
+  docker-swarm-ctl create host -f host.yaml
 
   # Create a service
-  docker-swarm-ctl create service --host <host-id> --name nginx --image nginx:latest`,
+  docker-swarm-ctl create service -f service.yaml --host <host-id>`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		resourceType := args[0]
 
-		switch resourceType {
-		case "host":
-			return fmt.Errorf("host creation not yet implemented")
-		case "service", "svc":
-			return fmt.Errorf("service creation not yet implemented")
-		case "secret":
-			return fmt.Errorf("secret creation not yet implemented")
-		case "config":
-			return fmt.Errorf("config creation not yet implemented")
-		default:
-			return fmt.Errorf("cannot create resource type: %s", resourceType)
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		hostOverride, _ := cmd.Flags().GetString("host")
+		file, _ := cmd.Flags().GetString("file")
+		image, _ := cmd.Flags().GetString("image")
+
+		if file == "" && (resourceType == "service" || resourceType == "svc") && image != "" {
+			return createServiceFromFlags(cmd, hostOverride, image)
+		}
+
+		if file == "" {
+			return fmt.Errorf("-f/--file is required")
+		}
+
+		wantKind, err := kindForResourceType(resourceType)
+		if err != nil {
+			return err
+		}
+
+		data, err := readManifestFile(file)
+		if err != nil {
+			return err
+		}
+
+		docs, err := manifest.DecodeStream(data)
+		if err != nil {
+			return err
+		}
+
+		doc, err := singleDocOfKind(docs, wantKind)
+		if err != nil {
+			return err
 		}
+
+		a := &applier{hostOverride: hostOverride}
+		return a.apply(doc)
 	},
 }
 
+// createServiceFromFlags creates a service directly from --name/--image/etc
+// flags (bypassing a manifest file), resolving registry credentials for
+// --image so private images can be pulled transparently.
+func createServiceFromFlags(cmd *cobra.Command, hostID, image string) error {
+	if hostID == "" {
+		return fmt.Errorf("--host is required")
+	}
+
+	name, _ := cmd.Flags().GetString("name")
+	if name == "" {
+		return fmt.Errorf("--name is required")
+	}
+	replicas, _ := cmd.Flags().GetInt("replicas")
+
+	registryAuth, err := apiClient.RetrieveAuthTokenFromImage(image)
+	if err != nil {
+		output.Warning("failed to resolve registry credentials for %s: %v", image, err)
+	}
+
+	svc, err := apiClient.CreateService(hostID, client.ServiceCreate{
+		Name:         name,
+		Image:        image,
+		Replicas:     replicas,
+		RegistryAuth: registryAuth,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create service %q: %w", name, err)
+	}
+
+	output.Success("service %q created", svc.Name)
+	return nil
+}
+
+func kindForResourceType(resourceType string) (string, error) {
+	switch resourceType {
+	case "host":
+		return manifest.KindHost, nil
+	case "service", "svc":
+		return manifest.KindService, nil
+	case "secret":
+		return manifest.KindSecret, nil
+	case "config":
+		return manifest.KindConfig, nil
+	default:
+		return "", fmt.Errorf("cannot create resource type: %s", resourceType)
+	}
+}
+
+func singleDocOfKind(docs []manifest.Document, kind string) (manifest.Document, error) {
+	var found []manifest.Document
+	for _, doc := range docs {
+		if doc.Kind == kind {
+			found = append(found, doc)
+		}
+	}
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		return manifest.Document{}, fmt.Errorf("manifest does not contain a %s document", kind)
+	default:
+		return manifest.Document{}, fmt.Errorf("manifest contains %d %s documents, expected exactly one", len(found), kind)
+	}
+}
+
 func init() {
 	createCmd.Flags().String("host", "", "Host ID (required for swarm resources)")
 	createCmd.Flags().StringP("file", "f", "", "Filename to use to create the resource")
-}
\ No newline at end of file
+	createCmd.Flags().String("name", "", "Resource name (when not using -f)")
+	createCmd.Flags().String("image", "", "Image to use for 'create service' (when not using -f)")
+	createCmd.Flags().Int("replicas", 1, "Number of replicas for 'create service' (when not using -f)")
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/output"
 )
 
 var deleteCmd = &cobra.Command{
@@ -29,15 +31,51 @@ Examples:
 		resourceType := args[0]
 		resourceName := args[1]
 
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
 		switch resourceType {
 		case "host":
-			return fmt.Errorf("deleting host %s not yet implemented", resourceName)
+			if err := apiClient.DeleteHost(resourceName); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("host %q deleted", resourceName)
+			return nil
 		case "service", "svc":
-			return fmt.Errorf("deleting service %s not yet implemented", resourceName)
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			svc, err := apiClient.GetService(hostID, resourceName)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			if err := apiClient.DeleteService(hostID, svc.ID); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("service %q deleted", resourceName)
+			return nil
 		case "secret":
-			return fmt.Errorf("deleting secret %s not yet implemented", resourceName)
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			if err := apiClient.DeleteSecret(hostID, resourceName); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("secret %q deleted", resourceName)
+			return nil
 		case "config":
-			return fmt.Errorf("deleting config %s not yet implemented", resourceName)
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			if err := apiClient.DeleteConfig(hostID, resourceName); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("config %q deleted", resourceName)
+			return nil
 		case "container":
 			return fmt.Errorf("deleting container %s not yet implemented", resourceName)
 		default:
@@ -49,4 +87,4 @@ Examples:
 func init() {
 	deleteCmd.Flags().String("host", "", "Host ID (required for swarm resources)")
 	deleteCmd.Flags().Bool("force", false, "Force deletion")
-}
\ No newline at end of file
+}
@@ -2,9 +2,15 @@ package cmd
 
 import (
 	"fmt"
-	"strings"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
 )
 
 var execCmd = &cobra.Command{
@@ -21,19 +27,120 @@ Examples:
 	Args: cobra.MinimumNArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		containerID := args[0]
-		command := strings.Join(args[1:], " ")
-		
+		command := args[1:]
+
 		hostID, err := requireHost(cmd)
 		if err != nil {
 			return err
 		}
 
-		return fmt.Errorf("executing command in container %s on host %s not yet implemented (command: %s)",
-			containerID, hostID, command)
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		stdin, _ := cmd.Flags().GetBool("stdin")
+		tty, _ := cmd.Flags().GetBool("tty")
+		envVars, _ := cmd.Flags().GetStringArray("env")
+		workdir, _ := cmd.Flags().GetString("workdir")
+		user, _ := cmd.Flags().GetString("user")
+
+		cfg := client.ExecConfig{
+			Cmd:          command,
+			Env:          envVars,
+			Tty:          tty,
+			AttachStdin:  stdin,
+			AttachStdout: true,
+			AttachStderr: true,
+			WorkingDir:   workdir,
+			User:         user,
+		}
+
+		session, err := apiClient.ContainerExec(hostID, containerID, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to exec in container %s: %w", containerID, err)
+		}
+		defer session.Close()
+
+		var restoreTerm func()
+		isRawTTY := tty && term.IsTerminal(int(os.Stdin.Fd()))
+		if isRawTTY {
+			oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
+			if err != nil {
+				return fmt.Errorf("failed to set raw terminal mode: %w", err)
+			}
+			restoreTerm = func() { term.Restore(int(os.Stdin.Fd()), oldState) }
+			defer restoreTerm()
+
+			resizeCh := make(chan os.Signal, 1)
+			signal.Notify(resizeCh, syscall.SIGWINCH)
+			go watchResize(resizeCh, session)
+			resizeCh <- syscall.SIGWINCH // trigger an initial resize
+		}
+
+		done := make(chan error, 2)
+
+		if stdin {
+			go func() {
+				buf := make([]byte, 4096)
+				for {
+					n, err := os.Stdin.Read(buf)
+					if n > 0 {
+						if werr := session.Write(buf[:n]); werr != nil {
+							done <- werr
+							return
+						}
+					}
+					if err != nil {
+						if err != io.EOF {
+							done <- err
+						}
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			done <- session.Stream(os.Stdout, os.Stderr, tty)
+		}()
+
+		streamErr := <-done
+
+		// Restore the terminal before exiting with the remote exit code:
+		// os.Exit skips deferred calls, so raw mode must be undone here.
+		if restoreTerm != nil {
+			restoreTerm()
+		}
+
+		if streamErr != nil && streamErr != io.EOF {
+			return streamErr
+		}
+
+		if inspect, ierr := session.Inspect(); ierr == nil && inspect.ExitCode != 0 {
+			os.Exit(inspect.ExitCode)
+		}
+		return nil
 	},
 }
 
+// watchResize forwards SIGWINCH notifications received on sigCh to the exec
+// session as resize requests, until sigCh is closed.
+func watchResize(sigCh <-chan os.Signal, session *client.ExecSession) {
+	for range sigCh {
+		width, height, err := term.GetSize(int(os.Stdout.Fd()))
+		if err != nil {
+			continue
+		}
+		session.Resize(height, width)
+	}
+}
+
 func init() {
 	execCmd.Flags().String("host", "", "Host ID (required)")
+	execCmd.Flags().BoolP("stdin", "i", false, "Attach stdin")
+	execCmd.Flags().BoolP("tty", "t", false, "Allocate a pseudo-TTY")
+	execCmd.Flags().StringArrayP("env", "e", nil, "Set environment variables (KEY=VALUE)")
+	execCmd.Flags().StringP("workdir", "w", "", "Working directory inside the container")
+	execCmd.Flags().StringP("user", "u", "", "Username or UID to run the command as")
 	execCmd.MarkFlagRequired("host")
-}
\ No newline at end of file
+}
@@ -39,6 +39,12 @@ Examples:
 			resourceName = args[1]
 		}
 
+		format, err := resolveOutputFormat(cmd)
+		if err != nil {
+			return err
+		}
+		outputFormat = format
+
 		switch resourceType {
 		case "hosts", "host":
 			return getHosts(cmd, resourceName)
@@ -67,7 +73,7 @@ func getHosts(cmd *cobra.Command, name string) error {
 		// Get specific host
 		host, err := apiClient.GetHost(name)
 		if err != nil {
-			return err
+			return handleAPIError(err)
 		}
 
 		printer := output.NewPrinter(outputFormat)
@@ -77,30 +83,10 @@ func getHosts(cmd *cobra.Command, name string) error {
 	// List all hosts
 	hosts, err := apiClient.ListHosts()
 	if err != nil {
-		return err
-	}
-
-	if outputFormat == "json" || outputFormat == "yaml" {
-		printer := output.NewPrinter(outputFormat)
-		return printer.Print(hosts)
+		return handleAPIError(err)
 	}
 
-	// Table output
-	headers := []string{"ID", "NAME", "URL", "ACTIVE", "CREATED"}
-	var rows [][]string
-
-	for _, host := range hosts {
-		rows = append(rows, []string{
-			output.TruncateID(host.ID, 12),
-			host.DisplayName,
-			host.URL,
-			output.FormatBool(host.IsActive),
-			output.FormatTimestamp(host.CreatedAt),
-		})
-	}
-
-	output.PrintTable(headers, rows)
-	return nil
+	return output.NewPrinter(outputFormat).Print(hosts)
 }
 
 func getNodes(cmd *cobra.Command, name string) error {
@@ -115,36 +101,10 @@ func getNodes(cmd *cobra.Command, name string) error {
 
 	nodes, err := apiClient.ListNodes(hostID)
 	if err != nil {
-		return err
-	}
-
-	if outputFormat == "json" || outputFormat == "yaml" {
-		printer := output.NewPrinter(outputFormat)
-		return printer.Print(nodes)
-	}
-
-	// Table output
-	headers := []string{"ID", "HOSTNAME", "STATUS", "AVAILABILITY", "MANAGER STATUS", "ENGINE VERSION"}
-	var rows [][]string
-
-	for _, node := range nodes {
-		managerStatus := node.ManagerStatus
-		if managerStatus == "" {
-			managerStatus = "-"
-		}
-
-		rows = append(rows, []string{
-			output.TruncateID(node.ID, 12),
-			node.Hostname,
-			node.Status,
-			node.Availability,
-			managerStatus,
-			node.EngineVersion,
-		})
+		return handleAPIError(err)
 	}
 
-	output.PrintTable(headers, rows)
-	return nil
+	return output.NewPrinter(outputFormat).Print(nodes)
 }
 
 func getServices(cmd *cobra.Command, name string) error {
@@ -159,35 +119,10 @@ func getServices(cmd *cobra.Command, name string) error {
 
 	services, err := apiClient.ListServices(hostID)
 	if err != nil {
-		return err
-	}
-
-	if outputFormat == "json" || outputFormat == "yaml" {
-		printer := output.NewPrinter(outputFormat)
-		return printer.Print(services)
-	}
-
-	// Table output
-	headers := []string{"ID", "NAME", "MODE", "REPLICAS", "IMAGE"}
-	var rows [][]string
-
-	for _, service := range services {
-		replicas := fmt.Sprintf("%d", service.Replicas)
-		if service.Mode != "replicated" {
-			replicas = service.Mode
-		}
-
-		rows = append(rows, []string{
-			output.TruncateID(service.ID, 12),
-			service.Name,
-			service.Mode,
-			replicas,
-			service.Image,
-		})
+		return handleAPIError(err)
 	}
 
-	output.PrintTable(headers, rows)
-	return nil
+	return output.NewPrinter(outputFormat).Print(services)
 }
 
 func getSecrets(cmd *cobra.Command, name string) error {
@@ -200,9 +135,12 @@ func getSecrets(cmd *cobra.Command, name string) error {
 		return err
 	}
 
-	// TODO: Implement secrets listing
-	output.Info("Listing secrets on host %s", hostID)
-	return nil
+	secrets, err := apiClient.ListSecrets(hostID)
+	if err != nil {
+		return handleAPIError(err)
+	}
+
+	return output.NewPrinter(outputFormat).Print(secrets)
 }
 
 func getConfigs(cmd *cobra.Command, name string) error {
@@ -215,9 +153,12 @@ func getConfigs(cmd *cobra.Command, name string) error {
 		return err
 	}
 
-	// TODO: Implement configs listing
-	output.Info("Listing configs on host %s", hostID)
-	return nil
+	configs, err := apiClient.ListConfigs(hostID)
+	if err != nil {
+		return handleAPIError(err)
+	}
+
+	return output.NewPrinter(outputFormat).Print(configs)
 }
 
 func getContainers(cmd *cobra.Command, name string) error {
@@ -230,9 +171,7 @@ func getContainers(cmd *cobra.Command, name string) error {
 		return err
 	}
 
-	// TODO: Implement containers listing
-	output.Info("Listing containers on host %s", hostID)
-	return nil
+	return fmt.Errorf("listing containers on host %s: not yet implemented", hostID)
 }
 
 func init() {
@@ -241,4 +180,6 @@ func init() {
 	getCmd.Flags().StringP("filter", "f", "", "Filter output")
 	getCmd.Flags().BoolP("watch", "w", false, "Watch for changes")
 	getCmd.Flags().BoolP("all-hosts", "A", false, "List resources from all hosts")
+	getCmd.Flags().String("format", "", "Go template string, e.g. '{{.Name}}' (mutually exclusive with --pretty)")
+	getCmd.Flags().Bool("pretty", false, "Pretty-print JSON output (mutually exclusive with --format)")
 }
\ No newline at end of file
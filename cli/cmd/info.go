@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	manager "github.com/docker-swarm-ctl/cli/pkg/cli-plugins/manager"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+// infoClientSection reports information the CLI knows without talking to a
+// server: its own version, configured contexts, and loaded plugins.
+type infoClientSection struct {
+	Version        string   `json:"version"`
+	CurrentContext string   `json:"currentContext,omitempty"`
+	Contexts       int      `json:"contexts"`
+	Plugins        []string `json:"plugins,omitempty"`
+}
+
+// infoHostSection reports the Swarm cluster state for a single registered
+// host.
+type infoHostSection struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	URL           string                 `json:"url"`
+	EngineVersion string                 `json:"engineVersion,omitempty"`
+	Nodes         int                    `json:"nodes"`
+	Managers      int                    `json:"managers"`
+	Orchestration map[string]interface{} `json:"orchestration,omitempty"`
+	Dispatcher    map[string]interface{} `json:"dispatcher,omitempty"`
+	Raft          map[string]interface{} `json:"raft,omitempty"`
+}
+
+// infoReport is the combined client+server report printed by `info`.
+// Server-side failures are collected in ServerErrors rather than aborting
+// the command, so client info is always available, matching the
+// split-error pattern `docker info` uses against an unreachable daemon.
+type infoReport struct {
+	Client       infoClientSection `json:"client"`
+	Hosts        []infoHostSection `json:"hosts,omitempty"`
+	ServerErrors []string          `json:"serverErrors,omitempty"`
+}
+
+var infoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Display client and server information",
+	Long: `Display a combined report of client-side state (CLI version,
+current context, configured contexts, loaded plugins) and server-side
+Swarm cluster state per registered host (node/manager counts,
+orchestration, dispatcher, and raft settings).
+
+If a host is unreachable, its failure is recorded and reporting
+continues for the rest; client info is always printed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := resolveOutputFormat(cmd)
+		if err != nil {
+			return err
+		}
+
+		report := buildInfoReport()
+
+		if format == "table" && !cmd.Flags().Changed("output") {
+			printInfoText(report)
+			return nil
+		}
+
+		printer := output.NewPrinter(format)
+		return printer.Print(report)
+	},
+}
+
+func buildInfoReport() infoReport {
+	report := infoReport{
+		Client: infoClientSection{
+			Version: Version,
+			Plugins: discoveredPluginNames(),
+		},
+	}
+
+	if cfg != nil {
+		report.Client.CurrentContext = cfg.CurrentContext
+		report.Client.Contexts = len(cfg.Contexts)
+	}
+
+	if apiClient == nil {
+		report.ServerErrors = append(report.ServerErrors, "not connected: no current context or not authenticated")
+		return report
+	}
+
+	hosts, err := apiClient.ListHosts()
+	if err != nil {
+		report.ServerErrors = append(report.ServerErrors, fmt.Sprintf("failed to list hosts: %v", err))
+		return report
+	}
+
+	for _, h := range hosts {
+		section := infoHostSection{ID: h.ID, Name: h.DisplayName, URL: h.URL}
+
+		if nodes, err := apiClient.ListNodes(h.ID); err != nil {
+			report.ServerErrors = append(report.ServerErrors, fmt.Sprintf("host %s: failed to list nodes: %v", h.DisplayName, err))
+		} else {
+			section.Nodes = len(nodes)
+			for _, n := range nodes {
+				if n.ManagerStatus != "" {
+					section.Managers++
+				}
+				if section.EngineVersion == "" {
+					section.EngineVersion = n.EngineVersion
+				}
+			}
+		}
+
+		if swarm, err := apiClient.GetSwarmInfo(h.ID); err != nil {
+			report.ServerErrors = append(report.ServerErrors, fmt.Sprintf("host %s: failed to get swarm info: %v", h.DisplayName, err))
+		} else {
+			section.Orchestration = swarm.Spec.Orchestration
+			section.Dispatcher = swarm.Spec.Dispatcher
+			section.Raft = swarm.Spec.Raft
+		}
+
+		report.Hosts = append(report.Hosts, section)
+	}
+
+	return report
+}
+
+// printInfoText renders report the way `docker info` does: a plain,
+// human-readable section list rather than the structured table/JSON
+// output the other commands produce.
+func printInfoText(report infoReport) {
+	fmt.Fprintf(os.Stdout, "Client:\n")
+	fmt.Fprintf(os.Stdout, " Version:\t%s\n", report.Client.Version)
+	if report.Client.CurrentContext != "" {
+		fmt.Fprintf(os.Stdout, " Context:\t%s\n", report.Client.CurrentContext)
+	}
+	fmt.Fprintf(os.Stdout, " Contexts:\t%d\n", report.Client.Contexts)
+	if len(report.Client.Plugins) > 0 {
+		fmt.Fprintf(os.Stdout, " Plugins:\t%v\n", report.Client.Plugins)
+	}
+
+	fmt.Fprintf(os.Stdout, "\nServer:\n")
+	for _, h := range report.Hosts {
+		fmt.Fprintf(os.Stdout, " %s (%s):\n", h.Name, h.ID)
+		fmt.Fprintf(os.Stdout, "  URL:            %s\n", h.URL)
+		fmt.Fprintf(os.Stdout, "  Engine Version: %s\n", h.EngineVersion)
+		fmt.Fprintf(os.Stdout, "  Nodes:          %d\n", h.Nodes)
+		fmt.Fprintf(os.Stdout, "  Managers:       %d\n", h.Managers)
+		if len(h.Orchestration) > 0 {
+			fmt.Fprintf(os.Stdout, "  Orchestration:  %v\n", h.Orchestration)
+		}
+		if len(h.Dispatcher) > 0 {
+			fmt.Fprintf(os.Stdout, "  Dispatcher:     %v\n", h.Dispatcher)
+		}
+		if len(h.Raft) > 0 {
+			fmt.Fprintf(os.Stdout, "  Raft:           %v\n", h.Raft)
+		}
+	}
+
+	if len(report.ServerErrors) > 0 {
+		fmt.Fprintf(os.Stdout, "\nServer Errors:\n")
+		for _, e := range report.ServerErrors {
+			fmt.Fprintf(os.Stdout, " %s\n", e)
+		}
+	}
+}
+
+// discoveredPluginNames returns the names of successfully discovered CLI
+// plugins, for the client info section.
+func discoveredPluginNames() []string {
+	plugins := manager.List()
+	names := make([]string, 0, len(plugins))
+	for _, p := range plugins {
+		if p.Err == nil {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+func init() {
+	infoCmd.Flags().String("format", "", "Go template string, e.g. '{{.Client.Version}}' (mutually exclusive with --pretty)")
+	infoCmd.Flags().Bool("pretty", false, "Pretty-print JSON output (mutually exclusive with --format)")
+}
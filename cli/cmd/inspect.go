@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect TYPE NAME",
+	Short: "Display detailed information on one resource",
+	Long: `Display detailed information on a single resource, defaulting to
+pretty-printed JSON (use --format for a Go template, or -o yaml).
+
+Resource types:
+  - host
+  - node
+  - service, svc
+  - secret
+  - config
+
+Examples:
+  docker-swarm-ctl inspect service nginx --host <host-id>
+  docker-swarm-ctl inspect node abc123 --host <host-id> --format '{{.Status}}'`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resourceType := strings.ToLower(args[0])
+		name := args[1]
+
+		format, err := resolveOutputFormat(cmd)
+		if err != nil {
+			return err
+		}
+		if format == "" || (format == "table" && !cmd.Flags().Changed("output")) {
+			format = "json"
+		}
+		printer := output.NewPrinter(format)
+
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		switch resourceType {
+		case "host":
+			item, err := apiClient.GetHost(name)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return printer.Print(item)
+
+		case "node":
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			nodes, err := apiClient.ListNodes(hostID)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			for _, n := range nodes {
+				if n.ID == name || n.Hostname == name {
+					return printer.Print(n)
+				}
+			}
+			return fmt.Errorf("node %q not found on host %s", name, hostID)
+
+		case "service", "svc":
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			item, err := apiClient.GetService(hostID, name)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			return printer.Print(item)
+
+		case "secret":
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			secrets, err := apiClient.ListSecrets(hostID)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			for _, s := range secrets {
+				if s.ID == name || s.Name == name {
+					return printer.Print(s)
+				}
+			}
+			return fmt.Errorf("secret %q not found on host %s", name, hostID)
+
+		case "config":
+			hostID, err := requireHost(cmd)
+			if err != nil {
+				return err
+			}
+			configs, err := apiClient.ListConfigs(hostID)
+			if err != nil {
+				return handleAPIError(err)
+			}
+			for _, c := range configs {
+				if c.ID == name || c.Name == name {
+					return printer.Print(c)
+				}
+			}
+			return fmt.Errorf("config %q not found on host %s", name, hostID)
+
+		default:
+			return fmt.Errorf("unknown resource type: %s", resourceType)
+		}
+	},
+}
+
+func init() {
+	inspectCmd.Flags().String("host", "", "Host ID (required for swarm resources)")
+	inspectCmd.Flags().String("format", "", "Go template string, e.g. '{{.Name}}' (mutually exclusive with --pretty)")
+	inspectCmd.Flags().Bool("pretty", false, "Pretty-print JSON output (mutually exclusive with --format)")
+}
@@ -1,36 +1,69 @@
 package cmd
 
 import (
-	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
 )
 
 var logsCmd = &cobra.Command{
 	Use:   "logs CONTAINER",
-	Short: "Print logs of a container",
-	Long: `Print the logs for a container.
+	Short: "Print logs of a container or service",
+	Long: `Print the logs for a container, or an aggregated stream across a
+service's replicas.
 
 Examples:
   # Get logs from nginx container
   docker-swarm-ctl logs nginx-abc123 --host <host-id>
 
   # Follow log output
-  docker-swarm-ctl logs nginx-abc123 --host <host-id> --follow`,
+  docker-swarm-ctl logs nginx-abc123 --host <host-id> --follow
+
+  # Tail aggregated logs across all replicas of a service
+  docker-swarm-ctl logs --service nginx --host <host-id> --follow`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		containerID := args[0]
+		name := args[0]
 		hostID, err := requireHost(cmd)
 		if err != nil {
 			return err
 		}
 
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
 		follow, _ := cmd.Flags().GetBool("follow")
 		tail, _ := cmd.Flags().GetInt("tail")
 		timestamps, _ := cmd.Flags().GetBool("timestamps")
+		service, _ := cmd.Flags().GetBool("service")
 
-		return fmt.Errorf("getting logs for container %s on host %s not yet implemented (follow=%v, tail=%d, timestamps=%v)",
-			containerID, hostID, follow, tail, timestamps)
+		opts := client.LogOptions{
+			Follow:     follow,
+			Tail:       tail,
+			Timestamps: timestamps,
+			Stdout:     true,
+			Stderr:     true,
+		}
+
+		stop := make(chan struct{})
+		if follow {
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigCh
+				close(stop)
+			}()
+		}
+
+		if service {
+			return apiClient.ServiceLogs(hostID, name, opts, os.Stdout, os.Stderr, stop)
+		}
+		return apiClient.ContainerLogs(hostID, name, opts, os.Stdout, os.Stderr, stop)
 	},
 }
 
@@ -39,5 +72,6 @@ func init() {
 	logsCmd.Flags().BoolP("follow", "f", false, "Follow log output")
 	logsCmd.Flags().Int("tail", 100, "Number of lines to show from the end")
 	logsCmd.Flags().BoolP("timestamps", "t", false, "Show timestamps")
+	logsCmd.Flags().Bool("service", false, "Tail aggregated logs across a service's replicas instead of a single container")
 	logsCmd.MarkFlagRequired("host")
-}
\ No newline at end of file
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/idresolver"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "Manage Swarm nodes",
+}
+
+var nodeLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List nodes",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return getNodes(cmd, "")
+	},
+}
+
+var nodeInspectCmd = &cobra.Command{
+	Use:   "inspect NODE",
+	Short: "Display detailed information on a node",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return inspectCmd.RunE(cmd, []string{"node", args[0]})
+	},
+}
+
+var nodePsCmd = &cobra.Command{
+	Use:   "ps NODE",
+	Short: "List tasks running on a node",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf("listing tasks for node %s not yet implemented", args[0])
+	},
+}
+
+var nodePromoteCmd = &cobra.Command{
+	Use:   "promote NODE [NODE...]",
+	Short: "Promote one or more nodes to manager",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateNodeRole(cmd, args, "manager")
+	},
+}
+
+var nodeDemoteCmd = &cobra.Command{
+	Use:   "demote NODE [NODE...]",
+	Short: "Demote one or more nodes from manager to worker",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateNodeRole(cmd, args, "worker")
+	},
+}
+
+var nodeUpdateCmd = &cobra.Command{
+	Use:   "update NODE",
+	Short: "Update a node's availability or labels",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		availability, _ := cmd.Flags().GetString("availability")
+		switch availability {
+		case "", "active", "pause", "drain":
+		default:
+			return fmt.Errorf("invalid --availability %q: must be one of active, pause, drain", availability)
+		}
+
+		labelAdd, _ := cmd.Flags().GetStringArray("label-add")
+		labelRm, _ := cmd.Flags().GetStringArray("label-rm")
+		labels, err := parseLabels(labelAdd)
+		if err != nil {
+			return err
+		}
+
+		nodeID, err := resolveNodeID(hostID, args[0])
+		if err != nil {
+			return err
+		}
+
+		node, err := apiClient.UpdateNode(hostID, nodeID, client.NodeUpdate{
+			Availability: availability,
+			LabelAdd:     labels,
+			LabelRm:      labelRm,
+		})
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		output.Success("node %q updated", node.Hostname)
+		return nil
+	},
+}
+
+var nodeRmCmd = &cobra.Command{
+	Use:     "rm NODE [NODE...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more nodes from the swarm",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		force, _ := cmd.Flags().GetBool("force")
+
+		nodeIDs, err := resolveNodeIDs(hostID, args)
+		if err != nil {
+			return err
+		}
+
+		for i, id := range nodeIDs {
+			if err := apiClient.DeleteNode(hostID, id, force); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("node %q removed", args[i])
+		}
+		return nil
+	},
+}
+
+// updateNodeRole resolves each of args to a node ID on hostID and sets its
+// role, used by both node promote and node demote.
+func updateNodeRole(cmd *cobra.Command, args []string, role string) error {
+	hostID, err := requireHost(cmd)
+	if err != nil {
+		return err
+	}
+	if err := requireAuth(); err != nil {
+		return err
+	}
+
+	nodeIDs, err := resolveNodeIDs(hostID, args)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range nodeIDs {
+		node, err := apiClient.UpdateNode(hostID, id, client.NodeUpdate{Role: role})
+		if err != nil {
+			return handleAPIError(err)
+		}
+		output.Success("node %q %s", node.Hostname, verbForRole(role))
+	}
+	return nil
+}
+
+func verbForRole(role string) string {
+	if role == "manager" {
+		return "promoted to manager"
+	}
+	return "demoted to worker"
+}
+
+// resolveNodeID resolves a single hostname/ID/prefix reference to a full
+// node ID on hostID.
+func resolveNodeID(hostID, ref string) (string, error) {
+	ids, err := resolveNodeIDs(hostID, []string{ref})
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// resolveNodeIDs resolves each of refs to full node IDs on hostID, sharing
+// a single ListNodes call across the batch.
+func resolveNodeIDs(hostID string, refs []string) ([]string, error) {
+	nodes, err := apiClient.ListNodes(hostID)
+	if err != nil {
+		return nil, handleAPIError(err)
+	}
+
+	resolverNodes := make([]idresolver.Node, len(nodes))
+	for i, n := range nodes {
+		resolverNodes[i] = idresolver.Node{ID: n.ID, Hostname: n.Hostname}
+	}
+
+	return idresolver.ResolveNodes(resolverNodes, refs)
+}
+
+// parseLabels parses "key=value" pairs as produced by a repeated
+// --label-add flag into a map.
+func parseLabels(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid label %q: expected key=value", pair)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeLsCmd)
+	nodeCmd.AddCommand(nodeInspectCmd)
+	nodeCmd.AddCommand(nodePsCmd)
+	nodeCmd.AddCommand(nodePromoteCmd)
+	nodeCmd.AddCommand(nodeDemoteCmd)
+	nodeCmd.AddCommand(nodeUpdateCmd)
+	nodeCmd.AddCommand(nodeRmCmd)
+
+	for _, c := range []*cobra.Command{nodeLsCmd, nodeInspectCmd, nodePsCmd, nodePromoteCmd, nodeDemoteCmd, nodeUpdateCmd, nodeRmCmd} {
+		c.Flags().String("host", "", "Host ID (required)")
+		c.MarkFlagRequired("host")
+	}
+
+	nodeUpdateCmd.Flags().String("availability", "", "Node availability (active, pause, drain)")
+	nodeUpdateCmd.Flags().StringArray("label-add", nil, "Add or update a node label (key=value)")
+	nodeUpdateCmd.Flags().StringArray("label-rm", nil, "Remove a node label by key")
+
+	nodeRmCmd.Flags().Bool("force", false, "Force removal of the node(s)")
+}
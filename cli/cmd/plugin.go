@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	manager "github.com/docker-swarm-ctl/cli/pkg/cli-plugins/manager"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+// pluginAnnotation marks a cobra.Command as backed by a discovered plugin
+// executable, so the usage template can group it under "Plugin Commands".
+const pluginAnnotation = "docker-swarm-ctl.plugin"
+
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Manage CLI plugins",
+}
+
+var pluginLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List discovered CLI plugins",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		plugins := manager.List()
+
+		if !output.IsTableFormat(outputFormat) {
+			printer := output.NewPrinter(outputFormat)
+			return printer.Print(plugins)
+		}
+
+		headers := []string{"NAME", "VERSION", "DESCRIPTION", "PATH", "STATUS"}
+		var rows [][]string
+		for _, p := range plugins {
+			status := "OK"
+			if p.Err != nil {
+				status = fmt.Sprintf("error: %v", p.Err)
+			}
+			rows = append(rows, []string{p.Name, p.Version, p.ShortDescription, p.Path, status})
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+// registerPlugins discovers plugin executables and adds one cobra.Command
+// per plugin to rootCmd, each shelling out to the plugin binary with the
+// current context's connection details passed via env vars.
+func registerPlugins(rootCmd *cobra.Command) {
+	for _, p := range manager.List() {
+		if p.Err != nil || hasSubcommand(rootCmd, p.Name) {
+			continue
+		}
+
+		plugin := p
+		short := plugin.ShortDescription
+		if short == "" {
+			short = fmt.Sprintf("%s (plugin)", plugin.Name)
+		}
+
+		shellCmd := &cobra.Command{
+			Use:                plugin.Name,
+			Short:              short,
+			Annotations:        map[string]string{pluginAnnotation: "true"},
+			DisableFlagParsing: true,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return manager.Run(plugin.Path, args, pluginEnv())
+			},
+		}
+		rootCmd.AddCommand(shellCmd)
+	}
+}
+
+// hasSubcommand reports whether rootCmd already has a child command named
+// name, so a plugin never shadows a built-in one.
+func hasSubcommand(rootCmd *cobra.Command, name string) bool {
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// pluginEnv builds the DSCTL_* environment variables a plugin needs to
+// reach the same host and context the CLI is currently using.
+func pluginEnv() []string {
+	var env []string
+	if apiClient != nil {
+		env = append(env, "DSCTL_API_URL="+apiClient.BaseURL, "DSCTL_TOKEN="+apiClient.Token)
+	}
+	if cfg != nil {
+		if ctx, ok := cfg.Contexts[cfg.CurrentContext]; ok {
+			env = append(env, "DSCTL_HOST="+ctx.DockerHost)
+		}
+	}
+	return env
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginLsCmd)
+}
@@ -1,14 +1,18 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/client/dockerdirect"
+	"github.com/docker-swarm-ctl/cli/pkg/client/errdefs"
 	"github.com/docker-swarm-ctl/cli/pkg/config"
 )
 
@@ -39,18 +43,31 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.docker-swarm-ctl/config.yaml)")
 	rootCmd.PersistentFlags().StringVar(&contextName, "context", "", "override current context")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "output format (table, json, yaml, wide)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "output format: table, wide, json, yaml, name, jsonpath={...}, go-template={...}, go-template-file=PATH, custom-columns=NAME:.field,...")
 
 	// Add subcommands
 	rootCmd.AddCommand(authCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(getCmd)
+	rootCmd.AddCommand(inspectCmd)
 	rootCmd.AddCommand(createCmd)
+	rootCmd.AddCommand(applyCmd)
 	rootCmd.AddCommand(deleteCmd)
 	rootCmd.AddCommand(scaleCmd)
 	rootCmd.AddCommand(logsCmd)
 	rootCmd.AddCommand(execCmd)
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(nodeCmd)
+	rootCmd.AddCommand(serviceCmd)
+	rootCmd.AddCommand(secretCmd)
+	rootCmd.AddCommand(configsCmd)
+	rootCmd.AddCommand(containerCmd)
+	rootCmd.AddCommand(stackCmd)
+	rootCmd.AddCommand(infoCmd)
+	rootCmd.AddCommand(pluginCmd)
+	registerPlugins(rootCmd)
+
+	SetupRootCommand(rootCmd)
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -93,7 +110,23 @@ func initConfig() {
 	// Initialize API client if we have a current context
 	if cfg.CurrentContext != "" {
 		if ctx, ok := cfg.Contexts[cfg.CurrentContext]; ok {
-			apiClient = client.New(ctx.APIUrl, ctx.Token)
+			if ctx.IsSSH() {
+				httpClient, err := dockerdirect.NewHTTPClient(dockerdirect.Config{
+					DockerHost:    ctx.DockerHost,
+					SSHKeyPath:    ctx.SSHKeyPath,
+					SSHKnownHosts: ctx.SSHKnownHosts,
+				})
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error connecting to ssh docker host: %v\n", err)
+				} else {
+					// The base URL is only used to build request paths; the
+					// actual connection is tunneled through httpClient.
+					apiClient = client.New("http://docker", ctx.Token)
+					apiClient.HTTPClient = httpClient
+				}
+			} else {
+				apiClient = client.New(ctx.APIUrl, ctx.Token)
+			}
 		}
 	}
 }
@@ -106,6 +139,48 @@ func requireAuth() error {
 	return nil
 }
 
+// handleAPIError inspects an error returned by apiClient for an
+// authentication failure, clears the stale token from the current context,
+// and prompts the user to log in again so the next invocation succeeds.
+func handleAPIError(err error) error {
+	if err == nil || !errors.Is(err, errdefs.ErrUnauthorized) {
+		return err
+	}
+
+	if cfg != nil && cfg.CurrentContext != "" {
+		if ctx, ok := cfg.Contexts[cfg.CurrentContext]; ok && ctx.Token != "" {
+			ctx.Token = ""
+			if configPath := viper.ConfigFileUsed(); configPath != "" {
+				cfg.Save(configPath)
+			}
+		}
+	}
+
+	return fmt.Errorf("session expired, please run 'docker-swarm-ctl auth login' again: %w", err)
+}
+
+// resolveOutputFormat reconciles the -o/--output flag with the --format and
+// --pretty flags supported by get and inspect, mirroring the mutually
+// exclusive --format/--pretty pair in upstream `docker service inspect`.
+func resolveOutputFormat(cmd *cobra.Command) (string, error) {
+	format, _ := cmd.Flags().GetString("format")
+	pretty, _ := cmd.Flags().GetBool("pretty")
+
+	if format != "" && pretty {
+		return "", fmt.Errorf("--format and --pretty are mutually exclusive")
+	}
+	if format != "" {
+		if strings.HasPrefix(format, "jsonpath=") || format == "name" {
+			return format, nil
+		}
+		return "go-template=" + format, nil
+	}
+	if pretty {
+		return "json", nil
+	}
+	return outputFormat, nil
+}
+
 // Helper function to require host parameter
 func requireHost(cmd *cobra.Command) (string, error) {
 	host, _ := cmd.Flags().GetString("host")
@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
 )
 
 var scaleCmd = &cobra.Command{
@@ -27,8 +30,28 @@ Examples:
 			return fmt.Errorf("replicas must be non-negative")
 		}
 
-		return fmt.Errorf("scaling service %s on host %s to %d replicas not yet implemented", 
-			serviceName, hostID, replicas)
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		svc, err := apiClient.GetService(hostID, serviceName)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		spec := client.ServiceCreate{
+			Name:     svc.Name,
+			Image:    svc.Image,
+			Replicas: replicas,
+			Mode:     svc.Mode,
+			Labels:   svc.Labels,
+		}
+		if _, err := apiClient.UpdateService(hostID, svc.ID, spec); err != nil {
+			return fmt.Errorf("failed to scale service %q: %w", serviceName, err)
+		}
+
+		output.Success("service %q scaled to %d replicas", serviceName, replicas)
+		return nil
 	},
 }
 
@@ -37,4 +60,4 @@ func init() {
 	scaleCmd.Flags().Int("replicas", 1, "Number of replicas")
 	scaleCmd.MarkFlagRequired("host")
 	scaleCmd.MarkFlagRequired("replicas")
-}
\ No newline at end of file
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+var secretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Manage Swarm secrets",
+}
+
+var secretLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List secrets",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		secrets, err := apiClient.ListSecrets(hostID)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		return output.NewPrinter(outputFormat).Print(secrets)
+	},
+}
+
+var secretCreateCmd = &cobra.Command{
+	Use:   "create SECRET FILE",
+	Short: "Create a secret from a file or STDIN",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		name := args[0]
+		data, err := readManifestFile(args[1])
+		if err != nil {
+			return err
+		}
+
+		secret, err := apiClient.CreateSecret(hostID, client.SecretCreate{
+			Name: name,
+			Data: string(data),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create secret %q: %w", name, err)
+		}
+
+		output.Success("secret %q created", secret.Name)
+		return nil
+	},
+}
+
+var secretInspectCmd = &cobra.Command{
+	Use:   "inspect SECRET",
+	Short: "Display detailed information on a secret",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return inspectCmd.RunE(cmd, []string{"secret", args[0]})
+	},
+}
+
+var secretRmCmd = &cobra.Command{
+	Use:     "rm SECRET [SECRET...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more secrets",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		for _, name := range args {
+			if err := apiClient.DeleteSecret(hostID, name); err != nil {
+				return handleAPIError(err)
+			}
+			output.Success("secret %q removed", name)
+		}
+		return nil
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(secretLsCmd)
+	secretCmd.AddCommand(secretCreateCmd)
+	secretCmd.AddCommand(secretInspectCmd)
+	secretCmd.AddCommand(secretRmCmd)
+
+	for _, c := range []*cobra.Command{secretLsCmd, secretCreateCmd, secretInspectCmd, secretRmCmd} {
+		c.Flags().String("host", "", "Host ID (required)")
+		c.MarkFlagRequired("host")
+	}
+}
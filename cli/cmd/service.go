@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var serviceCmd = &cobra.Command{
+	Use:     "service",
+	Aliases: []string{"svc"},
+	Short:   "Manage Swarm services",
+}
+
+var serviceLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List services",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return getServices(cmd, "")
+	},
+}
+
+var serviceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a service",
+	Long: `Create a service from a manifest file (-f) or directly from
+--name/--image flags, the same two paths supported by
+'docker-swarm-ctl create service'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createCmd.RunE(cmd, []string{"service"})
+	},
+}
+
+var serviceInspectCmd = &cobra.Command{
+	Use:   "inspect SERVICE",
+	Short: "Display detailed information on a service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return inspectCmd.RunE(cmd, []string{"service", args[0]})
+	},
+}
+
+var serviceScaleCmd = &cobra.Command{
+	Use:   "scale SERVICE --replicas=COUNT",
+	Short: "Scale a service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return scaleCmd.RunE(cmd, args)
+	},
+}
+
+var serviceRmCmd = &cobra.Command{
+	Use:     "rm SERVICE [SERVICE...]",
+	Aliases: []string{"remove"},
+	Short:   "Remove one or more services",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range args {
+			if err := deleteCmd.RunE(cmd, []string{"service", name}); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var serviceLogsCmd = &cobra.Command{
+	Use:   "logs SERVICE",
+	Short: "Print the aggregated logs across a service's replicas",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return logsCmd.RunE(cmd, args)
+	},
+}
+
+var servicePsCmd = &cobra.Command{
+	Use:   "ps SERVICE",
+	Short: "List the tasks of a service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf("listing tasks for service %s not yet implemented", args[0])
+	},
+}
+
+var serviceUpdateCmd = &cobra.Command{
+	Use:   "update SERVICE",
+	Short: "Update a service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf("updating service %s not yet implemented", args[0])
+	},
+}
+
+var serviceRollbackCmd = &cobra.Command{
+	Use:   "rollback SERVICE",
+	Short: "Revert changes to a service's previous version",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf("rolling back service %s not yet implemented", args[0])
+	},
+}
+
+func init() {
+	serviceCmd.AddCommand(serviceLsCmd)
+	serviceCmd.AddCommand(serviceCreateCmd)
+	serviceCmd.AddCommand(serviceInspectCmd)
+	serviceCmd.AddCommand(serviceScaleCmd)
+	serviceCmd.AddCommand(serviceRmCmd)
+	serviceCmd.AddCommand(serviceLogsCmd)
+	serviceCmd.AddCommand(servicePsCmd)
+	serviceCmd.AddCommand(serviceUpdateCmd)
+	serviceCmd.AddCommand(serviceRollbackCmd)
+
+	for _, c := range []*cobra.Command{serviceLsCmd, serviceCreateCmd, serviceInspectCmd, serviceScaleCmd, serviceRmCmd, serviceLogsCmd, servicePsCmd, serviceUpdateCmd, serviceRollbackCmd} {
+		c.Flags().String("host", "", "Host ID (required)")
+		c.MarkFlagRequired("host")
+	}
+
+	serviceCreateCmd.Flags().StringP("file", "f", "", "Filename to use to create the service")
+	serviceCreateCmd.Flags().String("name", "", "Service name (when not using -f)")
+	serviceCreateCmd.Flags().String("image", "", "Image to use (when not using -f)")
+	serviceCreateCmd.Flags().Int("replicas", 1, "Number of replicas (when not using -f)")
+
+	serviceScaleCmd.Flags().Int("replicas", 1, "Number of replicas")
+	serviceScaleCmd.MarkFlagRequired("replicas")
+
+	serviceLogsCmd.Flags().Bool("service", true, "Tail aggregated logs across a service's replicas")
+	serviceLogsCmd.Flags().MarkHidden("service")
+	serviceLogsCmd.Flags().BoolP("follow", "f", false, "Follow log output")
+	serviceLogsCmd.Flags().Int("tail", 100, "Number of lines to show from the end")
+	serviceLogsCmd.Flags().BoolP("timestamps", "t", false, "Show timestamps")
+}
@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/docker-swarm-ctl/cli/pkg/bundlefile"
+	"github.com/docker-swarm-ctl/cli/pkg/client"
+	"github.com/docker-swarm-ctl/cli/pkg/output"
+)
+
+// stackNamespaceLabel marks the services belonging to a stack, the same
+// label Docker's own DAB/Compose stack deploy uses.
+const stackNamespaceLabel = "com.docker.stack.namespace"
+
+var stackCmd = &cobra.Command{
+	Use:   "stack",
+	Short: "Manage Swarm stacks",
+}
+
+var stackDeployCmd = &cobra.Command{
+	Use:     "deploy -c FILE STACK",
+	Aliases: []string{"up"},
+	Short:   "Deploy a bundle or Compose file as a stack",
+	Long: `Deploy reads a Docker distributed application bundle (.dab) or a
+Compose v3 file and creates one service per entry, labeling each with
+com.docker.stack.namespace=STACK so they can later be listed or removed
+together.
+
+Examples:
+  docker-swarm-ctl stack deploy -c bundle.dab myapp --host <host-id>
+  docker-swarm-ctl stack deploy -c docker-compose.yml myapp --host <host-id>`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		namespace := args[0]
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		file, _ := cmd.Flags().GetString("compose-file")
+		if file == "" {
+			return fmt.Errorf("-c/--compose-file is required")
+		}
+
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		data, err := readManifestFile(file)
+		if err != nil {
+			return err
+		}
+
+		bundle, err := bundlefile.Load(file, data)
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(bundle.Services))
+		for name := range bundle.Services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			svc := bundle.Services[name]
+			if err := deployBundleService(hostID, namespace, name, svc); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// deployBundleService creates or updates the service for one bundle entry,
+// mirroring applyService's create-or-update behavior.
+func deployBundleService(hostID, namespace, name string, svc bundlefile.Service) error {
+	serviceName := namespace + "_" + name
+
+	labels := make(map[string]string, len(svc.Labels)+1)
+	for k, v := range svc.Labels {
+		labels[k] = v
+	}
+	labels[stackNamespaceLabel] = namespace
+
+	ports := make([]client.ServicePort, 0, len(svc.Ports))
+	for _, p := range svc.Ports {
+		ports = append(ports, client.ServicePort{Published: int(p.Port), Target: int(p.Port), Protocol: p.Protocol})
+	}
+
+	registryAuth, err := apiClient.RetrieveAuthTokenFromImage(svc.Image)
+	if err != nil {
+		output.Warning("failed to resolve registry credentials for %s: %v", svc.Image, err)
+	}
+
+	create := client.ServiceCreate{
+		Name:         serviceName,
+		Image:        svc.Image,
+		Env:          svc.Env,
+		Labels:       labels,
+		Ports:        ports,
+		RegistryAuth: registryAuth,
+	}
+
+	if existing, err := apiClient.GetService(hostID, serviceName); err == nil {
+		if _, err := apiClient.UpdateService(hostID, existing.ID, create); err != nil {
+			return fmt.Errorf("failed to update service %q: %w", serviceName, err)
+		}
+		output.Success("service %q updated", serviceName)
+		return nil
+	}
+
+	if _, err := apiClient.CreateService(hostID, create); err != nil {
+		return fmt.Errorf("failed to create service %q: %w", serviceName, err)
+	}
+	output.Success("service %q created", serviceName)
+	return nil
+}
+
+var stackLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List stacks",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		services, err := apiClient.ListServices(hostID)
+		if err != nil {
+			return handleAPIError(err)
+		}
+
+		counts := map[string]int{}
+		for _, svc := range services {
+			if ns := svc.Labels[stackNamespaceLabel]; ns != "" {
+				counts[ns]++
+			}
+		}
+
+		if !output.IsTableFormat(outputFormat) {
+			printer := output.NewPrinter(outputFormat)
+			return printer.Print(counts)
+		}
+
+		names := make([]string, 0, len(counts))
+		for name := range counts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		headers := []string{"NAME", "SERVICES"}
+		var rows [][]string
+		for _, name := range names {
+			rows = append(rows, []string{name, fmt.Sprintf("%d", counts[name])})
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+var stackServicesCmd = &cobra.Command{
+	Use:   "services STACK",
+	Short: "List the services in a stack",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		services, err := stackServices(hostID, args[0])
+		if err != nil {
+			return err
+		}
+
+		return output.NewPrinter(outputFormat).Print(services)
+	},
+}
+
+var stackPsCmd = &cobra.Command{
+	Use:   "ps STACK",
+	Short: "List the tasks in a stack",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf("listing tasks for stack %s not yet implemented", args[0])
+	},
+}
+
+var stackRmCmd = &cobra.Command{
+	Use:     "rm STACK [STACK...]",
+	Aliases: []string{"remove", "down"},
+	Short:   "Remove one or more stacks",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hostID, err := requireHost(cmd)
+		if err != nil {
+			return err
+		}
+		if err := requireAuth(); err != nil {
+			return err
+		}
+
+		for _, namespace := range args {
+			services, err := stackServices(hostID, namespace)
+			if err != nil {
+				return err
+			}
+			if len(services) == 0 {
+				output.Warning("nothing found in stack %q", namespace)
+				continue
+			}
+			for _, svc := range services {
+				if err := apiClient.DeleteService(hostID, svc.ID); err != nil {
+					return handleAPIError(err)
+				}
+			}
+			output.Success("stack %q removed", namespace)
+		}
+		return nil
+	},
+}
+
+// stackServices returns the services on hostID labeled as belonging to
+// namespace.
+func stackServices(hostID, namespace string) ([]client.Service, error) {
+	services, err := apiClient.ListServices(hostID)
+	if err != nil {
+		return nil, handleAPIError(err)
+	}
+
+	var result []client.Service
+	for _, svc := range services {
+		if svc.Labels[stackNamespaceLabel] == namespace {
+			result = append(result, svc)
+		}
+	}
+	return result, nil
+}
+
+func init() {
+	stackCmd.AddCommand(stackDeployCmd)
+	stackCmd.AddCommand(stackLsCmd)
+	stackCmd.AddCommand(stackServicesCmd)
+	stackCmd.AddCommand(stackPsCmd)
+	stackCmd.AddCommand(stackRmCmd)
+
+	for _, c := range []*cobra.Command{stackDeployCmd, stackLsCmd, stackServicesCmd, stackPsCmd, stackRmCmd} {
+		c.Flags().String("host", "", "Host ID (required)")
+		c.MarkFlagRequired("host")
+	}
+
+	stackDeployCmd.Flags().StringP("compose-file", "c", "", "Path to a bundle (.dab) or Compose file")
+	stackDeployCmd.MarkFlagRequired("compose-file")
+}
@@ -0,0 +1,186 @@
+package bundlefile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadCompose parses a useful subset of a Compose v3 file (image, command,
+// entrypoint, environment, labels, ports, working_dir, user, networks) into
+// a Bundlefile, so `stack deploy -c docker-compose.yml` can reuse the same
+// deploy path as a native DAB.
+func LoadCompose(data []byte) (*Bundlefile, error) {
+	var file composeFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid compose file: %w", err)
+	}
+
+	bundle := &Bundlefile{Version: file.Version, Services: make(map[string]Service, len(file.Services))}
+	for name, svc := range file.Services {
+		ports := make([]Port, len(svc.Ports))
+		for i, p := range svc.Ports {
+			ports[i] = Port(p)
+		}
+		bundle.Services[name] = Service{
+			Image:      svc.Image,
+			Command:    svc.Entrypoint,
+			Args:       svc.Command,
+			Env:        envList(svc.Environment),
+			Labels:     svc.Labels,
+			Ports:      ports,
+			WorkingDir: svc.WorkingDir,
+			User:       svc.User,
+			Networks:   svc.Networks,
+		}
+	}
+	return bundle, nil
+}
+
+func envList(m map[string]string) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	list := make([]string, 0, len(m))
+	for k, v := range m {
+		list = append(list, k+"="+v)
+	}
+	return list
+}
+
+type composeFile struct {
+	Version  string                    `yaml:"version"`
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image       string        `yaml:"image"`
+	Command     stringList    `yaml:"command"`
+	Entrypoint  stringList    `yaml:"entrypoint"`
+	Environment stringMap     `yaml:"environment"`
+	Labels      stringMap     `yaml:"labels"`
+	Ports       []composePort `yaml:"ports"`
+	WorkingDir  string        `yaml:"working_dir"`
+	User        string        `yaml:"user"`
+	Networks    stringList    `yaml:"networks"`
+}
+
+// stringList decodes either a single string (split on whitespace, the
+// Compose "shell form") or a YAML sequence of strings.
+type stringList []string
+
+func (s *stringList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		var str string
+		if err := value.Decode(&str); err != nil {
+			return err
+		}
+		*s = strings.Fields(str)
+		return nil
+	case yaml.SequenceNode:
+		var list []string
+		if err := value.Decode(&list); err != nil {
+			return err
+		}
+		*s = list
+		return nil
+	default:
+		return fmt.Errorf("expected a string or a list of strings")
+	}
+}
+
+// stringMap decodes either a YAML mapping or a list of "KEY=VALUE" strings,
+// the two forms Compose accepts for "environment" and "labels".
+type stringMap map[string]string
+
+func (m *stringMap) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.MappingNode:
+		raw := map[string]interface{}{}
+		if err := value.Decode(&raw); err != nil {
+			return err
+		}
+		result := make(map[string]string, len(raw))
+		for k, v := range raw {
+			result[k] = fmt.Sprintf("%v", v)
+		}
+		*m = result
+		return nil
+	case yaml.SequenceNode:
+		var list []string
+		if err := value.Decode(&list); err != nil {
+			return err
+		}
+		result := make(map[string]string, len(list))
+		for _, item := range list {
+			key, val, _ := strings.Cut(item, "=")
+			result[key] = val
+		}
+		*m = result
+		return nil
+	default:
+		return fmt.Errorf("expected a mapping or a list of KEY=VALUE strings")
+	}
+}
+
+// composePort decodes either Compose's short port syntax
+// ("published:target/protocol") or its long mapping form.
+type composePort Port
+
+func (p *composePort) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		var spec string
+		if err := value.Decode(&spec); err != nil {
+			return err
+		}
+		return p.parseShort(spec)
+	}
+
+	var long struct {
+		Target    uint16 `yaml:"target"`
+		Published uint16 `yaml:"published"`
+		Protocol  string `yaml:"protocol"`
+	}
+	if err := value.Decode(&long); err != nil {
+		return fmt.Errorf("invalid port mapping: %w", err)
+	}
+	// Port holds a single port number; prefer Target to match parseShort,
+	// which takes the segment after the last ":" (the container/target
+	// port in Compose's "published:target" short syntax) below.
+	p.Port = long.Published
+	if long.Target != 0 {
+		p.Port = long.Target
+	}
+	p.Protocol = long.Protocol
+	if p.Protocol == "" {
+		p.Protocol = "tcp"
+	}
+	return nil
+}
+
+// parseShort parses Compose's short port syntax, "[published:]target[/protocol]".
+// Port is set from target - the segment after the last ":" - to match the
+// long mapping form's priority above.
+func (p *composePort) parseShort(spec string) error {
+	proto := "tcp"
+	if idx := strings.LastIndex(spec, "/"); idx != -1 {
+		proto = spec[idx+1:]
+		spec = spec[:idx]
+	}
+
+	portStr := spec
+	if idx := strings.LastIndex(spec, ":"); idx != -1 {
+		portStr = spec[idx+1:]
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port %q: %w", spec, err)
+	}
+	p.Port = uint16(port)
+	p.Protocol = proto
+	return nil
+}
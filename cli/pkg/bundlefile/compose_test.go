@@ -0,0 +1,128 @@
+package bundlefile
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func decodePort(t *testing.T, doc string) composePort {
+	t.Helper()
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(doc), &node); err != nil {
+		t.Fatalf("yaml.Unmarshal() error: %v", err)
+	}
+	var p composePort
+	if err := p.UnmarshalYAML(node.Content[0]); err != nil {
+		t.Fatalf("UnmarshalYAML() error: %v", err)
+	}
+	return p
+}
+
+func TestComposePortShortAndLongAgree(t *testing.T) {
+	tests := []struct {
+		name     string
+		short    string
+		long     string
+		wantPort uint16
+		wantProt string
+	}{
+		{
+			name:     "published and target differ",
+			short:    `"8080:80"`,
+			long:     "{target: 80, published: 8080}",
+			wantPort: 80,
+			wantProt: "tcp",
+		},
+		{
+			name:     "with protocol",
+			short:    `"53:53/udp"`,
+			long:     "{target: 53, published: 53, protocol: udp}",
+			wantPort: 53,
+			wantProt: "udp",
+		},
+		{
+			name:     "target only, no published prefix",
+			short:    `"80"`,
+			long:     "{target: 80}",
+			wantPort: 80,
+			wantProt: "tcp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			short := decodePort(t, tt.short)
+			long := decodePort(t, tt.long)
+
+			if short.Port != tt.wantPort {
+				t.Errorf("short form Port = %d, want %d", short.Port, tt.wantPort)
+			}
+			if long.Port != tt.wantPort {
+				t.Errorf("long form Port = %d, want %d", long.Port, tt.wantPort)
+			}
+			if short.Port != long.Port {
+				t.Errorf("short and long forms disagree: short=%d long=%d", short.Port, long.Port)
+			}
+			if short.Protocol != tt.wantProt || long.Protocol != tt.wantProt {
+				t.Errorf("Protocol = short:%q long:%q, want %q", short.Protocol, long.Protocol, tt.wantProt)
+			}
+		})
+	}
+}
+
+func TestStringListUnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want []string
+	}{
+		{name: "shell form", doc: `"ls -la /etc"`, want: []string{"ls", "-la", "/etc"}},
+		{name: "sequence form", doc: "[ls, -la, /etc]", want: []string{"ls", "-la", "/etc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s stringList
+			if err := yaml.Unmarshal([]byte(tt.doc), &s); err != nil {
+				t.Fatalf("yaml.Unmarshal() error: %v", err)
+			}
+			if len(s) != len(tt.want) {
+				t.Fatalf("got %v, want %v", []string(s), tt.want)
+			}
+			for i := range tt.want {
+				if s[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", []string(s), tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestStringMapUnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want map[string]string
+	}{
+		{name: "mapping form", doc: "FOO: bar\nBAZ: 1", want: map[string]string{"FOO": "bar", "BAZ": "1"}},
+		{name: "list form", doc: "[FOO=bar, BAZ=qux]", want: map[string]string{"FOO": "bar", "BAZ": "qux"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m stringMap
+			if err := yaml.Unmarshal([]byte(tt.doc), &m); err != nil {
+				t.Fatalf("yaml.Unmarshal() error: %v", err)
+			}
+			if len(m) != len(tt.want) {
+				t.Fatalf("got %v, want %v", map[string]string(m), tt.want)
+			}
+			for k, v := range tt.want {
+				if m[k] != v {
+					t.Errorf("m[%q] = %q, want %q", k, m[k], v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package bundlefile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// LoadJSON strictly decodes a bundle in its native DAB JSON form, rejecting
+// unknown fields and surfacing byte-offset errors in the style of
+// json.SyntaxError / json.UnmarshalTypeError.
+func LoadJSON(data []byte) (*Bundlefile, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var bundle Bundlefile
+	if err := dec.Decode(&bundle); err != nil {
+		return nil, describeJSONError(err)
+	}
+	return &bundle, nil
+}
+
+// Load parses a bundle from path's contents, dispatching on its file
+// extension: ".dab" or ".json" are decoded as a native bundlefile, anything
+// else is treated as Compose v3 YAML.
+func Load(path string, data []byte) (*Bundlefile, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".dab", ".json":
+		return LoadJSON(data)
+	default:
+		return LoadCompose(data)
+	}
+}
+
+// describeJSONError annotates decode errors with a byte offset, mirroring
+// the messages Go's encoding/json produces natively for syntax errors.
+func describeJSONError(err error) error {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return fmt.Errorf("invalid bundle at offset %d: %w", e.Offset, err)
+	case *json.UnmarshalTypeError:
+		return fmt.Errorf("invalid bundle field %q at offset %d: expected %s, got %s", e.Field, e.Offset, e.Type, e.Value)
+	default:
+		return fmt.Errorf("invalid bundle: %w", err)
+	}
+}
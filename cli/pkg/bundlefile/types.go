@@ -0,0 +1,30 @@
+// Package bundlefile parses Docker Distributed Application Bundles (DABs)
+// and a useful subset of Compose v3 files into a common Bundlefile, so the
+// `stack` command can deploy either format the same way.
+package bundlefile
+
+// Port declares a single published port mapping for a bundled service.
+type Port struct {
+	Protocol string `json:"Protocol"`
+	Port     uint16 `json:"Port"`
+}
+
+// Service declares one service in a bundle.
+type Service struct {
+	Image      string            `json:"Image"`
+	Command    []string          `json:"Command,omitempty"`
+	Args       []string          `json:"Args,omitempty"`
+	Env        []string          `json:"Env,omitempty"`
+	Labels     map[string]string `json:"Labels,omitempty"`
+	Ports      []Port            `json:"Ports,omitempty"`
+	WorkingDir string            `json:"WorkingDir,omitempty"`
+	User       string            `json:"User,omitempty"`
+	Networks   []string          `json:"Networks,omitempty"`
+}
+
+// Bundlefile is the parsed form of a Docker distributed application bundle,
+// keyed by service name.
+type Bundlefile struct {
+	Version  string             `json:"Version"`
+	Services map[string]Service `json:"Services"`
+}
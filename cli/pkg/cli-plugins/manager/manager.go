@@ -0,0 +1,128 @@
+// Package manager discovers docker-swarm-ctl-<name> plugin executables on
+// $PATH and in the CLI's cli-plugins config directory, the same convention
+// the Docker CLI uses for its own cli-plugins.
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// pluginPrefix is prepended to every plugin's command name to form its
+// executable name, e.g. the "foo" plugin is "docker-swarm-ctl-foo".
+const pluginPrefix = "docker-swarm-ctl-"
+
+// metadataSubcommand is invoked on a discovered plugin binary to retrieve
+// its Metadata as JSON on stdout.
+const metadataSubcommand = "docker-swarm-ctl-cli-plugin-metadata"
+
+// Metadata describes a plugin, as reported by invoking it with
+// metadataSubcommand.
+type Metadata struct {
+	SchemaVersion    string `json:"SchemaVersion"`
+	Vendor           string `json:"Vendor"`
+	Version          string `json:"Version,omitempty"`
+	ShortDescription string `json:"ShortDescription,omitempty"`
+}
+
+// Plugin is one discovered plugin executable. Err is set when the plugin
+// was found on disk but its metadata could not be retrieved; Metadata is
+// then left empty.
+type Plugin struct {
+	Name string
+	Path string
+	Metadata
+	Err error
+}
+
+// List discovers plugins on $PATH and in the cli-plugins config directory,
+// querying each one's metadata. Plugins are returned sorted by name; the
+// first match for a given name wins, matching $PATH search order.
+func List() []Plugin {
+	seen := make(map[string]bool)
+	var plugins []Plugin
+
+	for _, dir := range searchDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), pluginPrefix) {
+				continue
+			}
+
+			name := strings.TrimPrefix(entry.Name(), pluginPrefix)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			path := filepath.Join(dir, entry.Name())
+			plugin := Plugin{Name: name, Path: path}
+			if meta, err := fetchMetadata(path); err != nil {
+				plugin.Err = err
+			} else {
+				plugin.Metadata = meta
+			}
+			plugins = append(plugins, plugin)
+		}
+	}
+
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins
+}
+
+// searchDirs returns the directories searched for plugin executables:
+// every entry of $PATH, then $XDG_CONFIG_HOME/docker-swarm-ctl/cli-plugins
+// (or ~/.config/... when XDG_CONFIG_HOME is unset).
+func searchDirs() []string {
+	var dirs []string
+	if path := os.Getenv("PATH"); path != "" {
+		dirs = append(dirs, filepath.SplitList(path)...)
+	}
+
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			configHome = filepath.Join(home, ".config")
+		}
+	}
+	if configHome != "" {
+		dirs = append(dirs, filepath.Join(configHome, "docker-swarm-ctl", "cli-plugins"))
+	}
+
+	return dirs
+}
+
+// fetchMetadata invokes path with metadataSubcommand and parses its JSON
+// stdout.
+func fetchMetadata(path string) (Metadata, error) {
+	out, err := exec.Command(path, metadataSubcommand).Output()
+	if err != nil {
+		return Metadata{}, fmt.Errorf("failed to query plugin metadata: %w", err)
+	}
+
+	var meta Metadata
+	if err := json.Unmarshal(out, &meta); err != nil {
+		return Metadata{}, fmt.Errorf("invalid plugin metadata: %w", err)
+	}
+	return meta, nil
+}
+
+// Run execs the plugin binary at path with args, inheriting the current
+// process's standard streams and environment plus env.
+func Run(path string, args []string, env []string) error {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), env...)
+	return cmd.Run()
+}
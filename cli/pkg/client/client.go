@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client/errdefs"
 )
 
 // Client represents the API client
@@ -30,7 +34,7 @@ func New(baseURL, token string) *Client {
 }
 
 // request performs an HTTP request
-func (c *Client) request(method, endpoint string, body interface{}, params map[string]string) (*http.Response, error) {
+func (c *Client) request(method, endpoint string, body interface{}, params map[string]string, headers map[string]string) (*http.Response, error) {
 	// Build URL
 	u, err := url.Parse(c.BaseURL + "/" + strings.TrimLeft(endpoint, "/"))
 	if err != nil {
@@ -69,6 +73,9 @@ func (c *Client) request(method, endpoint string, body interface{}, params map[s
 	if c.Token != "" {
 		req.Header.Set("Authorization", "Bearer "+c.Token)
 	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 
 	// Execute request
 	resp, err := c.HTTPClient.Do(req)
@@ -79,59 +86,130 @@ func (c *Client) request(method, endpoint string, body interface{}, params map[s
 	return resp, nil
 }
 
-// doRequest performs a request and handles the response
-func (c *Client) doRequest(method, endpoint string, body interface{}, params map[string]string, result interface{}) error {
-	resp, err := c.request(method, endpoint, body, params)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+// idempotentMethods are safe to retry automatically on a transient failure.
+var idempotentMethods = map[string]bool{"GET": true, "PUT": true, "DELETE": true}
 
-	// Read response body
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
-	}
+// maxRetries bounds the number of automatic retries doRequest performs for
+// rate-limited or unavailable responses to an idempotent request.
+const maxRetries = 3
+
+// doRequest performs a request and handles the response, retrying
+// idempotent requests on a rate-limited or unavailable response.
+func (c *Client) doRequest(method, endpoint string, body interface{}, params map[string]string, headers map[string]string, result interface{}) error {
+	var lastErr error
 
-	// Check status code
-	if resp.StatusCode >= 400 {
-		var errorResp struct {
-			Detail string `json:"detail"`
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		resp, err := c.request(method, endpoint, body, params, headers)
+		if err != nil {
+			return err
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
 		}
-		if err := json.Unmarshal(respBody, &errorResp); err == nil && errorResp.Detail != "" {
-			return fmt.Errorf("API error (%d): %s", resp.StatusCode, errorResp.Detail)
+
+		if resp.StatusCode >= 400 {
+			apiErr := parseAPIError(resp.StatusCode, resp.Header.Get("X-Request-ID"), respBody)
+			lastErr = apiErr
+
+			if idempotentMethods[method] && apiErr.Retryable() && attempt < maxRetries {
+				time.Sleep(retryDelay(attempt, resp.Header.Get("Retry-After")))
+				continue
+			}
+			return apiErr
+		}
+
+		if result != nil && len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, result); err != nil {
+				return fmt.Errorf("failed to parse response: %w", err)
+			}
 		}
-		return fmt.Errorf("API error (%d): %s", resp.StatusCode, string(respBody))
+
+		return nil
+	}
+
+	return lastErr
+}
+
+// parseAPIError builds a typed errdefs.APIError from a non-2xx response
+// body, decoding "detail" as either a plain string or a FastAPI-style list
+// of {loc,msg,type} validation errors.
+func parseAPIError(statusCode int, requestID string, respBody []byte) *errdefs.APIError {
+	var strDetail struct {
+		Detail string `json:"detail"`
+	}
+	if err := json.Unmarshal(respBody, &strDetail); err == nil && strDetail.Detail != "" {
+		apiErr := errdefs.New(statusCode, strDetail.Detail)
+		apiErr.RequestID = requestID
+		return apiErr
 	}
 
-	// Parse response if needed
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to parse response: %w", err)
+	var validationDetail struct {
+		Detail []errdefs.ValidationDetail `json:"detail"`
+	}
+	if err := json.Unmarshal(respBody, &validationDetail); err == nil && len(validationDetail.Detail) > 0 {
+		msgs := make([]string, 0, len(validationDetail.Detail))
+		for _, d := range validationDetail.Detail {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", strings.Join(d.Loc, "."), d.Msg))
 		}
+		apiErr := errdefs.New(statusCode, strings.Join(msgs, "; "))
+		apiErr.Code = "validation_error"
+		apiErr.Details = validationDetail.Detail
+		apiErr.RequestID = requestID
+		return apiErr
 	}
 
-	return nil
+	apiErr := errdefs.New(statusCode, string(respBody))
+	apiErr.RequestID = requestID
+	return apiErr
+}
+
+// retryDelay computes the backoff before the next retry attempt, honoring a
+// Retry-After header (seconds) when present and otherwise applying
+// exponential backoff with jitter.
+func retryDelay(attempt int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	base := time.Duration(1<<uint(attempt)) * 200 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(base) / 2))
+	return base + jitter
 }
 
 // GET performs a GET request
 func (c *Client) GET(endpoint string, params map[string]string, result interface{}) error {
-	return c.doRequest("GET", endpoint, nil, params, result)
+	return c.doRequest("GET", endpoint, nil, params, nil, result)
 }
 
 // POST performs a POST request
 func (c *Client) POST(endpoint string, body interface{}, params map[string]string, result interface{}) error {
-	return c.doRequest("POST", endpoint, body, params, result)
+	return c.doRequest("POST", endpoint, body, params, nil, result)
+}
+
+// POSTWithHeaders performs a POST request with additional request headers,
+// e.g. X-Registry-Auth for service create/update.
+func (c *Client) POSTWithHeaders(endpoint string, body interface{}, params, headers map[string]string, result interface{}) error {
+	return c.doRequest("POST", endpoint, body, params, headers, result)
+}
+
+// PUTWithHeaders performs a PUT request with additional request headers,
+// e.g. X-Registry-Auth for service create/update.
+func (c *Client) PUTWithHeaders(endpoint string, body interface{}, params, headers map[string]string, result interface{}) error {
+	return c.doRequest("PUT", endpoint, body, params, headers, result)
 }
 
 // PUT performs a PUT request
 func (c *Client) PUT(endpoint string, body interface{}, params map[string]string, result interface{}) error {
-	return c.doRequest("PUT", endpoint, body, params, result)
+	return c.doRequest("PUT", endpoint, body, params, nil, result)
 }
 
 // DELETE performs a DELETE request
 func (c *Client) DELETE(endpoint string, params map[string]string, result interface{}) error {
-	return c.doRequest("DELETE", endpoint, nil, params, result)
+	return c.doRequest("DELETE", endpoint, nil, params, nil, result)
 }
 
 // Login authenticates and stores the token
@@ -181,12 +259,12 @@ func (c *Client) Login(username, password string) error {
 
 // Host operations
 type Host struct {
-	ID          string    `json:"id"`
-	DisplayName string    `json:"display_name"`
-	URL         string    `json:"url"`
-	IsActive    bool      `json:"is_active"`
+	ID          string    `json:"id" table:"ID,truncate=12"`
+	DisplayName string    `json:"display_name" table:"NAME"`
+	URL         string    `json:"url" table:"URL"`
+	IsActive    bool      `json:"is_active" table:"ACTIVE,bool"`
 	TLSEnabled  bool      `json:"tls_enabled"`
-	CreatedAt   time.Time `json:"created_at"`
+	CreatedAt   time.Time `json:"created_at" table:"CREATED,timestamp"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
@@ -235,13 +313,13 @@ func (c *Client) GetSwarmInfo(hostID string) (*SwarmInfo, error) {
 
 // Node operations
 type Node struct {
-	ID             string `json:"id"`
-	Hostname       string `json:"hostname"`
-	Status         string `json:"status"`
-	Availability   string `json:"availability"`
-	Role           string `json:"role"`
-	ManagerStatus  string `json:"manager_status,omitempty"`
-	EngineVersion  string `json:"engine_version"`
+	ID            string `json:"id" table:"ID,truncate=12"`
+	Hostname      string `json:"hostname" table:"HOSTNAME"`
+	Status        string `json:"status" table:"STATUS"`
+	Availability  string `json:"availability" table:"AVAILABILITY"`
+	Role          string `json:"role" table:"ROLE,wide"`
+	ManagerStatus string `json:"manager_status,omitempty" table:"MANAGER STATUS,omitempty"`
+	EngineVersion string `json:"engine_version" table:"ENGINE VERSION"`
 }
 
 type NodeList struct {
@@ -260,11 +338,12 @@ func (c *Client) ListNodes(hostID string) ([]Node, error) {
 
 // Service operations
 type Service struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Image    string `json:"image"`
-	Replicas int    `json:"replicas"`
-	Mode     string `json:"mode"`
+	ID       string            `json:"id" table:"ID,truncate=12"`
+	Name     string            `json:"name" table:"NAME"`
+	Image    string            `json:"image" table:"IMAGE"`
+	Replicas int               `json:"replicas" table:"REPLICAS"`
+	Mode     string            `json:"mode" table:"MODE"`
+	Labels   map[string]string `json:"labels,omitempty" table:"LABELS,wide"`
 }
 
 type ServiceList struct {
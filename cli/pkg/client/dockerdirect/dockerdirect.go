@@ -0,0 +1,195 @@
+// Package dockerdirect dials a remote Docker daemon's unix socket through an
+// SSH connection, so commands can talk directly to a daemon (e.g. on an
+// air-gapped host) instead of going through the REST API server.
+package dockerdirect
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// remoteDockerSock is the path of the Docker daemon's socket on the remote
+// host, matching Docker's own default.
+const remoteDockerSock = "/var/run/docker.sock"
+
+// Config describes how to reach a remote Docker daemon over SSH.
+type Config struct {
+	// DockerHost is a ssh://[user@]host[:port] URL.
+	DockerHost string
+	// SSHKeyPath is an optional path to a private key; when empty
+	// $HOME/.ssh/id_rsa is used. The SSH agent is also tried whenever
+	// SSH_AUTH_SOCK is set.
+	SSHKeyPath string
+	// SSHKnownHosts is an optional path to a known_hosts file used for
+	// strict host-key checking; when empty $HOME/.ssh/known_hosts is used.
+	SSHKnownHosts string
+}
+
+// NewHTTPClient returns an *http.Client whose transport tunnels every
+// connection through SSH to the remote Docker socket, so it is a drop-in
+// replacement for the HTTP client used to talk to the REST API.
+func NewHTTPClient(cfg Config) (*http.Client, error) {
+	user, host, err := parseDockerHost(cfg.DockerHost)
+	if err != nil {
+		return nil, err
+	}
+
+	clientConfig, err := buildSSHConfig(user, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel := &sshTunnel{host: host, config: clientConfig}
+
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return tunnel.dial()
+			},
+		},
+	}, nil
+}
+
+// sshTunnel lazily dials a single shared ssh.Client for a host and reuses it
+// for every tunneled docker-socket connection the transport opens, closing
+// it once the last such connection closes rather than leaking one ssh.Client
+// per HTTP connection. A new ssh.Client is dialed on demand if a later
+// connection arrives after the shared one has been closed.
+type sshTunnel struct {
+	host   string
+	config *ssh.ClientConfig
+
+	mu     sync.Mutex
+	client *ssh.Client
+	refs   int
+}
+
+func (t *sshTunnel) dial() (net.Conn, error) {
+	t.mu.Lock()
+	if t.client == nil {
+		client, err := ssh.Dial("tcp", t.host, t.config)
+		if err != nil {
+			t.mu.Unlock()
+			return nil, fmt.Errorf("failed to dial %s over ssh: %w", t.host, err)
+		}
+		t.client = client
+	}
+	client := t.client
+	t.refs++
+	t.mu.Unlock()
+
+	conn, err := client.Dial("unix", remoteDockerSock)
+	if err != nil {
+		t.release()
+		return nil, fmt.Errorf("failed to dial docker socket on %s: %w", t.host, err)
+	}
+	return &tunnelConn{Conn: conn, tunnel: t}, nil
+}
+
+// release drops a reference to the shared ssh.Client, closing it once no
+// tunneled connections remain open.
+func (t *sshTunnel) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refs--
+	if t.refs <= 0 {
+		if t.client != nil {
+			t.client.Close()
+		}
+		t.client = nil
+		t.refs = 0
+	}
+}
+
+// tunnelConn wraps the net.Conn returned by sshTunnel.dial so that closing
+// it releases the shared ssh.Client instead of leaking it.
+type tunnelConn struct {
+	net.Conn
+	tunnel    *sshTunnel
+	closeOnce sync.Once
+}
+
+func (c *tunnelConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(c.tunnel.release)
+	return err
+}
+
+// parseDockerHost splits a ssh://[user@]host[:port] URL into its user and
+// host:port components, defaulting the user to $USER and the port to 22.
+func parseDockerHost(dockerHost string) (user, hostPort string, err error) {
+	rest := strings.TrimPrefix(dockerHost, "ssh://")
+	if rest == dockerHost {
+		return "", "", fmt.Errorf("docker host %q is not an ssh:// URL", dockerHost)
+	}
+
+	if at := strings.Index(rest, "@"); at >= 0 {
+		user = rest[:at]
+		rest = rest[at+1:]
+	} else {
+		user = os.Getenv("USER")
+	}
+
+	if !strings.Contains(rest, ":") {
+		rest = rest + ":22"
+	}
+
+	return user, rest, nil
+}
+
+// buildSSHConfig assembles an ssh.ClientConfig using agent and key-file
+// auth, and strict host-key checking via a known_hosts callback.
+func buildSSHConfig(user string, cfg Config) (*ssh.ClientConfig, error) {
+	var methods []ssh.AuthMethod
+
+	if sockPath := os.Getenv("SSH_AUTH_SOCK"); sockPath != "" {
+		if conn, err := net.Dial("unix", sockPath); err == nil {
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	keyPath := cfg.SSHKeyPath
+	if keyPath == "" {
+		home, _ := os.UserHomeDir()
+		keyPath = home + "/.ssh/id_rsa"
+	}
+	if key, err := os.ReadFile(keyPath); err == nil {
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ssh key %s: %w", keyPath, err)
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no ssh authentication method available: provide --ssh-key or run an ssh-agent")
+	}
+
+	knownHostsPath := cfg.SSHKnownHosts
+	if knownHostsPath == "" {
+		home, _ := os.UserHomeDir()
+		knownHostsPath = home + "/.ssh/known_hosts"
+	}
+	hostKeyCallback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known_hosts %s: %w", knownHostsPath, err)
+	}
+
+	return &ssh.ClientConfig{
+		User:            user,
+		Auth:            methods,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         10 * time.Second,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Package errdefs defines the typed API error taxonomy returned by
+// pkg/client, modeled on Docker's own errdefs package: a sentinel error per
+// class so callers can do errors.Is(err, errdefs.ErrNotFound) instead of
+// string-matching.
+package errdefs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors, one per API error class. APIError wraps the sentinel
+// matching its status code so callers can use errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrValidation   = errors.New("validation failed")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrUnavailable  = errors.New("unavailable")
+)
+
+// ValidationDetail is a single FastAPI-style validation error entry.
+type ValidationDetail struct {
+	Loc  []string `json:"loc"`
+	Msg  string   `json:"msg"`
+	Type string   `json:"type"`
+}
+
+// APIError is the concrete error type produced for any non-2xx API
+// response.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    []ValidationDetail
+	RequestID  string
+}
+
+// New builds an APIError for the given status code and message.
+func New(statusCode int, message string) *APIError {
+	return &APIError{StatusCode: statusCode, Message: message}
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "API error (%d): %s", e.StatusCode, e.Message)
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request_id: %s)", e.RequestID)
+	}
+	return b.String()
+}
+
+// Unwrap exposes the sentinel matching this error's status code so
+// errors.Is(err, errdefs.ErrNotFound) (etc.) works against a returned
+// *APIError.
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == 401:
+		return ErrUnauthorized
+	case e.StatusCode == 403:
+		return ErrForbidden
+	case e.StatusCode == 404:
+		return ErrNotFound
+	case e.StatusCode == 409:
+		return ErrConflict
+	case e.StatusCode == 422:
+		return ErrValidation
+	case e.StatusCode == 429:
+		return ErrRateLimited
+	case e.StatusCode >= 500:
+		return ErrUnavailable
+	default:
+		return nil
+	}
+}
+
+// Retryable reports whether the error represents a transient condition
+// worth retrying for an idempotent request (rate limiting or a 5xx).
+func (e *APIError) Retryable() bool {
+	return e.StatusCode == 429 || e.StatusCode >= 500
+}
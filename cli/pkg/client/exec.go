@@ -0,0 +1,130 @@
+package client
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gorilla/websocket"
+)
+
+// ExecConfig describes an exec instance to create in a container.
+type ExecConfig struct {
+	Cmd          []string
+	Env          []string
+	Tty          bool
+	AttachStdin  bool
+	AttachStdout bool
+	AttachStderr bool
+	WorkingDir   string
+	User         string
+}
+
+// ExecSession is an attached WebSocket connection to a running exec
+// instance, along with the instance ID needed for resize requests.
+type ExecSession struct {
+	ID     string
+	conn   *websocket.Conn
+	client *Client
+	hostID string
+}
+
+// ContainerExec creates an exec instance for containerID on hostID and
+// attaches to it over a WebSocket hijack.
+func (c *Client) ContainerExec(hostID, containerID string, cfg ExecConfig) (*ExecSession, error) {
+	body := map[string]interface{}{
+		"Cmd":          cfg.Cmd,
+		"Env":          cfg.Env,
+		"Tty":          cfg.Tty,
+		"AttachStdin":  cfg.AttachStdin,
+		"AttachStdout": cfg.AttachStdout,
+		"AttachStderr": cfg.AttachStderr,
+		"WorkingDir":   cfg.WorkingDir,
+		"User":         cfg.User,
+	}
+
+	var created struct {
+		ID string `json:"Id"`
+	}
+	endpoint := fmt.Sprintf("/hosts/%s/containers/%s/exec", hostID, containerID)
+	if err := c.POST(endpoint, body, nil, &created); err != nil {
+		return nil, fmt.Errorf("failed to create exec instance: %w", err)
+	}
+
+	wsURL, err := c.wsURL(fmt.Sprintf("/hosts/%s/containers/%s/exec/%s/attach", hostID, containerID, created.ID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, resp, err := websocket.DefaultDialer.Dial(wsURL, wsHeader(c.Token))
+	if err != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		return nil, fmt.Errorf("failed to attach to exec instance (%d): %w", status, err)
+	}
+
+	return &ExecSession{ID: created.ID, conn: conn, client: c, hostID: hostID}, nil
+}
+
+// Read reads a chunk of output from the exec session.
+func (s *ExecSession) Read() ([]byte, error) {
+	_, data, err := s.conn.ReadMessage()
+	return data, err
+}
+
+// Stream copies the session's output to stdout/stderr until the connection
+// closes or an error occurs. A TTY session has no Docker stream-framing
+// header, so its frames are copied through to stdout as-is; a non-TTY
+// session is demultiplexed the same way demuxWS splits logs frames.
+func (s *ExecSession) Stream(stdout, stderr io.Writer, tty bool) error {
+	if tty {
+		for {
+			_, data, err := s.conn.ReadMessage()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					return nil
+				}
+				return err
+			}
+			if _, err := stdout.Write(data); err != nil {
+				return err
+			}
+		}
+	}
+	return demuxWS(s.conn, stdout, stderr)
+}
+
+// ExecInspect reports an exec instance's current status, including its exit
+// code once the command has finished running.
+type ExecInspect struct {
+	ExitCode int  `json:"ExitCode"`
+	Running  bool `json:"Running"`
+}
+
+// Inspect fetches the exec instance's current status.
+func (s *ExecSession) Inspect() (*ExecInspect, error) {
+	var result ExecInspect
+	endpoint := fmt.Sprintf("/hosts/%s/exec/%s/json", s.hostID, s.ID)
+	if err := s.client.GET(endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Write sends stdin bytes to the exec session.
+func (s *ExecSession) Write(data []byte) error {
+	return s.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// Close closes the underlying WebSocket connection.
+func (s *ExecSession) Close() error {
+	return s.conn.Close()
+}
+
+// Resize sends a TTY resize event for the exec session.
+func (s *ExecSession) Resize(height, width int) error {
+	body := map[string]int{"Height": height, "Width": width}
+	endpoint := fmt.Sprintf("/hosts/%s/exec/%s/resize", s.hostID, s.ID)
+	return s.client.POST(endpoint, body, nil, nil)
+}
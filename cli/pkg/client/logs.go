@@ -0,0 +1,234 @@
+package client
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsHeader builds the Authorization header used when dialing a WebSocket
+// endpoint, mirroring the headers doRequest sets for plain HTTP calls.
+func wsHeader(token string) http.Header {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	return header
+}
+
+// LogOptions configures a container or service log request.
+type LogOptions struct {
+	Follow     bool
+	Tail       int
+	Timestamps bool
+	Stdout     bool
+	Stderr     bool
+}
+
+// dockerStreamHeaderSize is the size, in bytes, of the framing header Docker
+// prepends to each chunk of a multiplexed attach/logs stream: 1 byte stream
+// type, 3 reserved bytes, and a 4-byte big-endian payload length.
+const dockerStreamHeaderSize = 8
+
+const (
+	streamTypeStdin = iota
+	streamTypeStdout
+	streamTypeStderr
+)
+
+// wsURL rewrites an http(s) base URL to its ws(s) equivalent.
+func (c *Client) wsURL(endpoint string, params map[string]string) (string, error) {
+	u, err := url.Parse(c.BaseURL + "/" + strings.TrimLeft(endpoint, "/"))
+	if err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	if params != nil {
+		q := u.Query()
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// logParams builds the shared query parameters for container/service log
+// endpoints from a LogOptions value.
+func logParams(opts LogOptions) map[string]string {
+	params := map[string]string{
+		"stdout":     strconv.FormatBool(opts.Stdout || !opts.Stderr),
+		"stderr":     strconv.FormatBool(opts.Stderr || !opts.Stdout),
+		"timestamps": strconv.FormatBool(opts.Timestamps),
+	}
+	if opts.Follow {
+		params["follow"] = "1"
+	}
+	if opts.Tail > 0 {
+		params["tail"] = strconv.Itoa(opts.Tail)
+	}
+	return params
+}
+
+// ContainerLogs streams logs for a container on hostID, demultiplexing the
+// Docker stream header and writing stdout/stderr frames to the matching
+// writer. When opts.Follow is true it upgrades to a WebSocket stream and
+// blocks until the connection closes or the stop channel is closed.
+func (c *Client) ContainerLogs(hostID, containerID string, opts LogOptions, stdout, stderr io.Writer, stop <-chan struct{}) error {
+	endpoint := fmt.Sprintf("/hosts/%s/containers/%s/logs", hostID, containerID)
+	if !opts.Follow {
+		return c.fetchLogs(endpoint, opts, stdout, stderr)
+	}
+	return c.streamLogsWS(endpoint, opts, stdout, stderr, stop)
+}
+
+// ServiceLogs streams aggregated logs across a service's replicas, mirroring
+// the Swarm service-logs endpoint. Each line is prefixed by the backend with
+// its task/node origin, so no additional demultiplexing is required here
+// beyond the standard stdout/stderr stream header.
+func (c *Client) ServiceLogs(hostID, serviceID string, opts LogOptions, stdout, stderr io.Writer, stop <-chan struct{}) error {
+	endpoint := fmt.Sprintf("/hosts/%s/services/%s/logs", hostID, serviceID)
+	if !opts.Follow {
+		return c.fetchLogs(endpoint, opts, stdout, stderr)
+	}
+	return c.streamLogsWS(endpoint, opts, stdout, stderr, stop)
+}
+
+// fetchLogs performs a one-shot chunked-transfer GET for non-follow requests.
+func (c *Client) fetchLogs(endpoint string, opts LogOptions, stdout, stderr io.Writer) error {
+	resp, err := c.request("GET", endpoint, nil, logParams(opts), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return parseAPIError(resp.StatusCode, resp.Header.Get("X-Request-ID"), body)
+	}
+
+	return demux(resp.Body, stdout, stderr)
+}
+
+// streamLogsWS dials the logs endpoint as a WebSocket, demultiplexing frames
+// as they arrive until the connection is closed, an error occurs, or stop is
+// closed. On a transient disconnect it reconnects with exponential backoff.
+func (c *Client) streamLogsWS(endpoint string, opts LogOptions, stdout, stderr io.Writer, stop <-chan struct{}) error {
+	wsURL, err := c.wsURL(endpoint, logParams(opts))
+	if err != nil {
+		return err
+	}
+
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for {
+		header := wsHeader(c.Token)
+		conn, resp, err := websocket.DefaultDialer.Dial(wsURL, header)
+		if err != nil {
+			if resp != nil && resp.StatusCode < 500 {
+				return fmt.Errorf("failed to connect to log stream (%d)", resp.StatusCode)
+			}
+			select {
+			case <-stop:
+				return nil
+			case <-time.After(backoff):
+			}
+			if backoff < maxBackoff {
+				backoff *= 2
+			}
+			continue
+		}
+		backoff = time.Second
+
+		done := make(chan error, 1)
+		go func() { done <- demuxWS(conn, stdout, stderr) }()
+
+		select {
+		case <-stop:
+			conn.Close()
+			return nil
+		case err := <-done:
+			conn.Close()
+			if err == nil || err == io.EOF {
+				return nil
+			}
+			// Transient disconnect: retry with backoff.
+			select {
+			case <-stop:
+				return nil
+			case <-time.After(backoff):
+			}
+			if backoff < maxBackoff {
+				backoff *= 2
+			}
+		}
+	}
+}
+
+// demux splits a multiplexed Docker stream into stdout/stderr writers.
+func demux(r io.Reader, stdout, stderr io.Writer) error {
+	header := make([]byte, dockerStreamHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		frameSize := binary.BigEndian.Uint32(header[4:8])
+		w := stdout
+		if header[0] == streamTypeStderr {
+			w = stderr
+		}
+
+		if _, err := io.CopyN(w, r, int64(frameSize)); err != nil {
+			return err
+		}
+	}
+}
+
+// demuxWS reads demultiplexed frames off a WebSocket connection, one Docker
+// stream frame per message.
+func demuxWS(conn *websocket.Conn, stdout, stderr io.Writer) error {
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+			return err
+		}
+		if len(data) < dockerStreamHeaderSize {
+			continue
+		}
+		frameSize := binary.BigEndian.Uint32(data[4:8])
+		payload := data[dockerStreamHeaderSize:]
+		if int64(len(payload)) > int64(frameSize) {
+			payload = payload[:frameSize]
+		}
+		w := stdout
+		if data[0] == streamTypeStderr {
+			w = stderr
+		}
+		if _, err := w.Write(payload); err != nil {
+			return err
+		}
+	}
+}
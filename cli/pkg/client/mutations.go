@@ -0,0 +1,221 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/docker-swarm-ctl/cli/pkg/client/registry"
+)
+
+// RetrieveAuthTokenFromImage resolves the registry credentials for image
+// from the local Docker config (credsStore/credHelpers/plaintext auths) and
+// returns them base64-url-encoded for use as the X-Registry-Auth header.
+func (c *Client) RetrieveAuthTokenFromImage(image string) (string, error) {
+	auth, err := registry.ResolveAuth(image)
+	if err != nil {
+		return "", err
+	}
+	return registry.Encode(auth)
+}
+
+// NodeUpdate describes the mutable fields of a Swarm node. Only non-zero
+// fields are applied; LabelRm takes label keys to remove.
+type NodeUpdate struct {
+	Role         string            `json:"role,omitempty"`
+	Availability string            `json:"availability,omitempty"`
+	LabelAdd     map[string]string `json:"label_add,omitempty"`
+	LabelRm      []string          `json:"label_rm,omitempty"`
+}
+
+// UpdateNode applies role, availability, and/or label changes to nodeID on
+// hostID.
+func (c *Client) UpdateNode(hostID, nodeID string, spec NodeUpdate) (*Node, error) {
+	var result Node
+	params := map[string]string{"host_id": hostID}
+	if err := c.PUT("/nodes/"+nodeID, spec, params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteNode removes nodeID from the swarm on hostID.
+func (c *Client) DeleteNode(hostID, nodeID string, force bool) error {
+	params := map[string]string{"host_id": hostID}
+	if force {
+		params["force"] = "true"
+	}
+	return c.DELETE("/nodes/"+nodeID, params, nil)
+}
+
+// HostCreate describes the fields accepted when registering a new host.
+type HostCreate struct {
+	DisplayName string `json:"display_name"`
+	URL         string `json:"url"`
+	TLSEnabled  bool   `json:"tls_enabled"`
+}
+
+// CreateHost registers a new Docker host with the backend.
+func (c *Client) CreateHost(spec HostCreate) (*Host, error) {
+	var result Host
+	if err := c.POST("/hosts/", spec, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteHost removes a registered host.
+func (c *Client) DeleteHost(hostID string) error {
+	return c.DELETE("/hosts/"+hostID, nil, nil)
+}
+
+// ServiceCreate describes the fields accepted when creating or updating a
+// Swarm service.
+type ServiceCreate struct {
+	Name     string            `json:"name"`
+	Image    string            `json:"image"`
+	Replicas int               `json:"replicas,omitempty"`
+	Mode     string            `json:"mode,omitempty"`
+	Env      []string          `json:"env,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Ports    []ServicePort     `json:"ports,omitempty"`
+
+	// RegistryAuth is sent as the X-Registry-Auth header rather than in the
+	// JSON body, matching the Docker Engine API convention.
+	RegistryAuth string `json:"-"`
+}
+
+// ServicePort describes a single published port mapping for a service.
+type ServicePort struct {
+	Published int    `json:"published"`
+	Target    int    `json:"target"`
+	Protocol  string `json:"protocol,omitempty"`
+}
+
+// CreateService creates a new Swarm service on hostID.
+func (c *Client) CreateService(hostID string, spec ServiceCreate) (*Service, error) {
+	var result Service
+	params := map[string]string{"host_id": hostID}
+	if err := c.POSTWithHeaders("/services", spec, params, registryAuthHeader(spec.RegistryAuth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateService updates an existing Swarm service on hostID.
+func (c *Client) UpdateService(hostID, serviceID string, spec ServiceCreate) (*Service, error) {
+	var result Service
+	params := map[string]string{"host_id": hostID}
+	if err := c.PUTWithHeaders("/services/"+serviceID, spec, params, registryAuthHeader(spec.RegistryAuth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// registryAuthHeader returns the X-Registry-Auth header map for a resolved
+// auth token, or nil when there is none to attach.
+func registryAuthHeader(token string) map[string]string {
+	if token == "" {
+		return nil
+	}
+	return map[string]string{"X-Registry-Auth": token}
+}
+
+// DeleteService removes a Swarm service from hostID.
+func (c *Client) DeleteService(hostID, serviceID string) error {
+	params := map[string]string{"host_id": hostID}
+	return c.DELETE("/services/"+serviceID, params, nil)
+}
+
+func (c *Client) GetService(hostID, name string) (*Service, error) {
+	services, err := c.ListServices(hostID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range services {
+		if services[i].Name == name {
+			return &services[i], nil
+		}
+	}
+	return nil, fmt.Errorf("service %q not found on host %s", name, hostID)
+}
+
+// Secret operations
+type Secret struct {
+	ID        string `json:"id" table:"ID,truncate=12"`
+	Name      string `json:"name" table:"NAME"`
+	CreatedAt string `json:"created_at" table:"CREATED"`
+}
+
+type SecretCreate struct {
+	Name   string            `json:"name"`
+	Data   string            `json:"data"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type SecretList struct {
+	Secrets []Secret `json:"secrets"`
+	Total   int      `json:"total"`
+}
+
+func (c *Client) ListSecrets(hostID string) ([]Secret, error) {
+	var result SecretList
+	params := map[string]string{"host_id": hostID}
+	if err := c.GET("/secrets", params, &result); err != nil {
+		return nil, err
+	}
+	return result.Secrets, nil
+}
+
+func (c *Client) CreateSecret(hostID string, spec SecretCreate) (*Secret, error) {
+	var result Secret
+	params := map[string]string{"host_id": hostID}
+	if err := c.POST("/secrets", spec, params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) DeleteSecret(hostID, secretID string) error {
+	params := map[string]string{"host_id": hostID}
+	return c.DELETE("/secrets/"+secretID, params, nil)
+}
+
+// Config operations
+type Config struct {
+	ID        string `json:"id" table:"ID,truncate=12"`
+	Name      string `json:"name" table:"NAME"`
+	CreatedAt string `json:"created_at" table:"CREATED"`
+}
+
+type ConfigCreate struct {
+	Name   string            `json:"name"`
+	Data   string            `json:"data"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type ConfigList struct {
+	Configs []Config `json:"configs"`
+	Total   int      `json:"total"`
+}
+
+func (c *Client) ListConfigs(hostID string) ([]Config, error) {
+	var result ConfigList
+	params := map[string]string{"host_id": hostID}
+	if err := c.GET("/configs", params, &result); err != nil {
+		return nil, err
+	}
+	return result.Configs, nil
+}
+
+func (c *Client) CreateConfig(hostID string, spec ConfigCreate) (*Config, error) {
+	var result Config
+	params := map[string]string{"host_id": hostID}
+	if err := c.POST("/configs", spec, params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) DeleteConfig(hostID, configID string) error {
+	params := map[string]string{"host_id": hostID}
+	return c.DELETE("/configs/"+configID, params, nil)
+}
@@ -0,0 +1,169 @@
+// Package registry resolves registry credentials the same way the Docker
+// CLI does, so private images can be pulled when creating or updating a
+// service without the user re-entering credentials.
+package registry
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// defaultIndexServer is used when an image reference has no registry host,
+// matching Docker Hub's canonical config.json key.
+const defaultIndexServer = "https://index.docker.io/v1/"
+
+// AuthConfig is the credential payload Docker attaches to requests that
+// pull or push against a registry.
+type AuthConfig struct {
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	ServerAddress string `json:"serveraddress"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+}
+
+// dockerConfig mirrors the subset of ~/.docker/config.json this package
+// needs to resolve credentials for an image reference.
+type dockerConfig struct {
+	Auths       map[string]dockerConfigAuth `json:"auths"`
+	CredsStore  string                      `json:"credsStore"`
+	CredHelpers map[string]string           `json:"credHelpers"`
+}
+
+type dockerConfigAuth struct {
+	Auth string `json:"auth"`
+}
+
+// loadDockerConfig reads $DOCKER_CONFIG/config.json, falling back to
+// ~/.docker/config.json.
+func loadDockerConfig() (*dockerConfig, error) {
+	path := os.Getenv("DOCKER_CONFIG")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine home directory: %w", err)
+		}
+		path = filepath.Join(home, ".docker")
+	}
+	path = filepath.Join(path, "config.json")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &dockerConfig{}, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var cfg dockerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// indexServerForImage extracts the registry host portion of an image
+// reference (registry/repo:tag), defaulting to Docker Hub when the image
+// has no explicit registry component.
+func indexServerForImage(image string) string {
+	ref := strings.SplitN(image, "/", 2)
+	if len(ref) < 2 {
+		return defaultIndexServer
+	}
+
+	host := ref[0]
+	if !strings.ContainsAny(host, ".:") && host != "localhost" {
+		// Not a registry host (e.g. "library/nginx" or a plain "nginx" repo
+		// with a namespace) -- still Docker Hub.
+		return defaultIndexServer
+	}
+	return host
+}
+
+// ResolveAuth resolves the AuthConfig for the registry that owns image,
+// consulting credHelpers, credsStore, and plaintext auths in config.json in
+// that order, matching the Docker CLI's own precedence.
+func ResolveAuth(image string) (AuthConfig, error) {
+	cfg, err := loadDockerConfig()
+	if err != nil {
+		return AuthConfig{}, err
+	}
+
+	server := indexServerForImage(image)
+
+	if helper, ok := cfg.CredHelpers[server]; ok {
+		return authFromHelper(helper, server)
+	}
+
+	if cfg.CredsStore != "" {
+		if auth, err := authFromHelper(cfg.CredsStore, server); err == nil {
+			return auth, nil
+		}
+	}
+
+	if entry, ok := cfg.Auths[server]; ok && entry.Auth != "" {
+		return authFromEncoded(entry.Auth, server)
+	}
+
+	return AuthConfig{ServerAddress: server}, nil
+}
+
+// authFromHelper invokes a docker-credential-<helper> binary following the
+// credential helper protocol: the server address on stdin to "get",
+// {ServerURL,Username,Secret} JSON back on stdout.
+func authFromHelper(helper, server string) (AuthConfig, error) {
+	cmd := exec.Command("docker-credential-"+helper, "get")
+	cmd.Stdin = strings.NewReader(server)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return AuthConfig{}, fmt.Errorf("docker-credential-%s get failed: %w", helper, err)
+	}
+
+	var resp struct {
+		ServerURL string `json:"ServerURL"`
+		Username  string `json:"Username"`
+		Secret    string `json:"Secret"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return AuthConfig{}, fmt.Errorf("failed to parse docker-credential-%s output: %w", helper, err)
+	}
+
+	return AuthConfig{
+		Username:      resp.Username,
+		Password:      resp.Secret,
+		ServerAddress: server,
+	}, nil
+}
+
+// authFromEncoded decodes a config.json "auth" field, a base64 "user:pass"
+// pair.
+func authFromEncoded(encoded, server string) (AuthConfig, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return AuthConfig{}, fmt.Errorf("failed to decode auth entry for %s: %w", server, err)
+	}
+
+	parts := strings.SplitN(string(decoded), ":", 2)
+	auth := AuthConfig{ServerAddress: server}
+	if len(parts) == 2 {
+		auth.Username, auth.Password = parts[0], parts[1]
+	}
+	return auth, nil
+}
+
+// Encode base64-url-encodes an AuthConfig for the X-Registry-Auth header,
+// matching Docker's own registry.EncodeAuthConfig.
+func Encode(auth AuthConfig) (string, error) {
+	data, err := json.Marshal(auth)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode registry auth: %w", err)
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
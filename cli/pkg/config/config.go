@@ -8,12 +8,29 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// Context types understood by the root command when deciding how to reach
+// the configured Docker Swarm environment.
+const (
+	ContextTypeAPI = "api"
+	ContextTypeSSH = "ssh"
+)
+
 // Context represents a configuration context
 type Context struct {
-	APIUrl    string `yaml:"api_url"`
-	Username  string `yaml:"username,omitempty"`
-	Token     string `yaml:"token,omitempty"`
-	VerifySSL bool   `yaml:"verify_ssl"`
+	Type          string `yaml:"type,omitempty"`
+	APIUrl        string `yaml:"api_url,omitempty"`
+	Username      string `yaml:"username,omitempty"`
+	Token         string `yaml:"token,omitempty"`
+	VerifySSL     bool   `yaml:"verify_ssl"`
+	DockerHost    string `yaml:"docker_host,omitempty"`
+	SSHKeyPath    string `yaml:"ssh_key_path,omitempty"`
+	SSHKnownHosts string `yaml:"ssh_known_hosts,omitempty"`
+}
+
+// IsSSH reports whether the context talks directly to a Docker daemon over
+// SSH rather than through the REST API server.
+func (c *Context) IsSSH() bool {
+	return c.Type == ContextTypeSSH
 }
 
 // Config represents the CLI configuration
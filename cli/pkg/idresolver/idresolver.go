@@ -0,0 +1,55 @@
+// Package idresolver resolves the short IDs, hostnames, or names a user
+// types on the command line to the full resource IDs the backend API
+// expects, the same convenience `docker node` commands offer.
+package idresolver
+
+import "fmt"
+
+// Node is the subset of client.Node fields needed to resolve a reference.
+type Node struct {
+	ID       string
+	Hostname string
+}
+
+// ResolveNode finds the full node ID matching ref among nodes. ref may be a
+// full ID, an ID prefix, or an exact hostname. An empty or ambiguous match
+// is reported as an error naming ref.
+func ResolveNode(nodes []Node, ref string) (string, error) {
+	for _, n := range nodes {
+		if n.ID == ref || n.Hostname == ref {
+			return n.ID, nil
+		}
+	}
+
+	var prefixMatches []Node
+	for _, n := range nodes {
+		if len(ref) >= 4 && hasPrefix(n.ID, ref) {
+			prefixMatches = append(prefixMatches, n)
+		}
+	}
+	switch len(prefixMatches) {
+	case 1:
+		return prefixMatches[0].ID, nil
+	case 0:
+		return "", fmt.Errorf("node %q not found", ref)
+	default:
+		return "", fmt.Errorf("node ID %q is ambiguous, matches %d nodes", ref, len(prefixMatches))
+	}
+}
+
+// ResolveNodes resolves each ref in refs, stopping at the first failure.
+func ResolveNodes(nodes []Node, refs []string) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		id, err := ResolveNode(nodes, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
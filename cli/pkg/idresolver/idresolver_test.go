@@ -0,0 +1,59 @@
+package idresolver
+
+import "testing"
+
+func TestResolveNode(t *testing.T) {
+	nodes := []Node{
+		{ID: "abcdef123456", Hostname: "manager-1"},
+		{ID: "abcdef789000", Hostname: "worker-1"},
+		{ID: "112233445566", Hostname: "worker-2"},
+	}
+
+	tests := []struct {
+		name    string
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{name: "exact ID", ref: "112233445566", want: "112233445566"},
+		{name: "exact hostname", ref: "worker-1", want: "abcdef789000"},
+		{name: "unambiguous ID prefix", ref: "1122", want: "112233445566"},
+		{name: "ambiguous ID prefix", ref: "abcdef", wantErr: true},
+		{name: "too-short prefix is not matched", ref: "abc", wantErr: true},
+		{name: "no match", ref: "does-not-exist", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveNode(nodes, tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ResolveNode(%q) = %q, want an error", tt.ref, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveNode(%q) unexpected error: %v", tt.ref, err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveNode(%q) = %q, want %q", tt.ref, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveNodesStopsAtFirstFailure(t *testing.T) {
+	nodes := []Node{{ID: "abc123", Hostname: "manager-1"}}
+
+	if _, err := ResolveNodes(nodes, []string{"manager-1", "missing"}); err == nil {
+		t.Fatal("ResolveNodes() with an unresolvable ref: expected an error, got nil")
+	}
+
+	ids, err := ResolveNodes(nodes, []string{"manager-1"})
+	if err != nil {
+		t.Fatalf("ResolveNodes() unexpected error: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "abc123" {
+		t.Errorf("ResolveNodes() = %v, want [abc123]", ids)
+	}
+}
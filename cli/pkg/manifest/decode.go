@@ -0,0 +1,90 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document is a single decoded manifest document, still in its raw JSON
+// form. Callers inspect Kind to decide which typed Spec to decode into.
+type Document struct {
+	Kind string
+	JSON json.RawMessage
+}
+
+// DecodeStream splits a multi-document YAML (or single-document JSON) stream
+// into individual manifest documents. Each document's kind is resolved up
+// front so callers can dispatch before performing the strict typed decode.
+func DecodeStream(data []byte) ([]Document, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var docs []Document
+	for {
+		var node yaml.Node
+		if err := dec.Decode(&node); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse manifest: %w", err)
+		}
+		if node.Kind == 0 {
+			continue
+		}
+
+		raw, err := yamlToJSON(&node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifest: %w", err)
+		}
+
+		var meta TypeMeta
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return nil, describeJSONError(err, raw)
+		}
+		if meta.Kind == "" {
+			return nil, fmt.Errorf("manifest document is missing a \"kind\" field")
+		}
+
+		docs = append(docs, Document{Kind: meta.Kind, JSON: raw})
+	}
+
+	return docs, nil
+}
+
+// yamlToJSON round-trips a yaml.Node through an interface{} decode/encode so
+// the result can be fed to the stricter encoding/json decoder below.
+func yamlToJSON(node *yaml.Node) (json.RawMessage, error) {
+	var v interface{}
+	if err := node.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// Decode strictly unmarshals a document's JSON into the given typed spec,
+// rejecting unknown fields and surfacing byte-offset errors in the style of
+// json.SyntaxError / json.UnmarshalTypeError.
+func Decode(doc Document, out interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(doc.JSON))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(out); err != nil {
+		return describeJSONError(err, doc.JSON)
+	}
+	return nil
+}
+
+// describeJSONError annotates decode errors with a byte offset, mirroring
+// the messages Go's encoding/json produces natively for syntax errors.
+func describeJSONError(err error, raw []byte) error {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return fmt.Errorf("invalid manifest at offset %d: %w", e.Offset, err)
+	case *json.UnmarshalTypeError:
+		return fmt.Errorf("invalid manifest field %q at offset %d: expected %s, got %s", e.Field, e.Offset, e.Type, e.Value)
+	default:
+		return fmt.Errorf("invalid manifest: %w", err)
+	}
+}
@@ -0,0 +1,131 @@
+package manifest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeStream(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantKinds []string
+		wantErr   string
+	}{
+		{
+			name: "single document",
+			input: `
+kind: Host
+metadata:
+  name: docker-1
+spec:
+  url: tcp://localhost:2375
+`,
+			wantKinds: []string{KindHost},
+		},
+		{
+			name: "multi-document stream",
+			input: `
+kind: Secret
+metadata:
+  name: db-password
+spec:
+  data: hunter2
+---
+kind: Config
+metadata:
+  name: nginx-conf
+spec:
+  data: "server {}"
+`,
+			wantKinds: []string{KindSecret, KindConfig},
+		},
+		{
+			name:    "missing kind",
+			input:   `metadata: {name: docker-1}`,
+			wantErr: "missing a \"kind\" field",
+		},
+		{
+			name:    "invalid yaml",
+			input:   "kind: [unterminated",
+			wantErr: "failed to parse manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docs, err := DecodeStream([]byte(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DecodeStream() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeStream() unexpected error: %v", err)
+			}
+			if len(docs) != len(tt.wantKinds) {
+				t.Fatalf("DecodeStream() returned %d docs, want %d", len(docs), len(tt.wantKinds))
+			}
+			for i, want := range tt.wantKinds {
+				if docs[i].Kind != want {
+					t.Errorf("docs[%d].Kind = %q, want %q", i, docs[i].Kind, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeRejectsUnknownFields(t *testing.T) {
+	docs, err := DecodeStream([]byte(`
+kind: Host
+metadata:
+  name: docker-1
+spec:
+  url: tcp://localhost:2375
+  bogusField: true
+`))
+	if err != nil {
+		t.Fatalf("DecodeStream() unexpected error: %v", err)
+	}
+
+	var spec HostSpec
+	err = Decode(docs[0], &spec)
+	if err == nil {
+		t.Fatal("Decode() with an unknown field: expected an error, got nil")
+	}
+}
+
+func TestDecodeHostSpec(t *testing.T) {
+	docs, err := DecodeStream([]byte(`
+kind: Host
+metadata:
+  name: docker-1
+  labels:
+    env: prod
+spec:
+  url: tcp://localhost:2375
+  tlsEnabled: true
+`))
+	if err != nil {
+		t.Fatalf("DecodeStream() unexpected error: %v", err)
+	}
+
+	var spec HostSpec
+	if err := Decode(docs[0], &spec); err != nil {
+		t.Fatalf("Decode() unexpected error: %v", err)
+	}
+
+	if spec.Metadata.Name != "docker-1" {
+		t.Errorf("Metadata.Name = %q, want %q", spec.Metadata.Name, "docker-1")
+	}
+	if spec.Metadata.Labels["env"] != "prod" {
+		t.Errorf("Metadata.Labels[env] = %q, want %q", spec.Metadata.Labels["env"], "prod")
+	}
+	if spec.Spec.URL != "tcp://localhost:2375" {
+		t.Errorf("Spec.URL = %q, want %q", spec.Spec.URL, "tcp://localhost:2375")
+	}
+	if !spec.Spec.TLSEnabled {
+		t.Error("Spec.TLSEnabled = false, want true")
+	}
+}
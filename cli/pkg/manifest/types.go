@@ -0,0 +1,91 @@
+// Package manifest implements the typed specs and decoding used by
+// `docker-swarm-ctl apply -f`, modeled on kubectl-style manifests and
+// Docker's distributed application bundles.
+package manifest
+
+// Metadata holds the common identifying fields shared by every resource
+// kind.
+type Metadata struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// TypeMeta holds the discriminator fields present on every document in a
+// manifest stream.
+type TypeMeta struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+}
+
+// HostSpec declares a Docker host to register with the backend.
+type HostSpec struct {
+	TypeMeta
+	Metadata Metadata `json:"metadata"`
+	Spec     struct {
+		URL        string `json:"url"`
+		TLSEnabled bool   `json:"tlsEnabled,omitempty"`
+	} `json:"spec"`
+}
+
+// PortSpec declares a single published port mapping for a service.
+type PortSpec struct {
+	Published int    `json:"published"`
+	Target    int    `json:"target"`
+	Protocol  string `json:"protocol,omitempty"`
+}
+
+// ServiceSpec declares a Swarm service.
+type ServiceSpec struct {
+	TypeMeta
+	Metadata Metadata `json:"metadata"`
+	Spec     struct {
+		Host     string     `json:"host"`
+		Image    string     `json:"image"`
+		Replicas int        `json:"replicas,omitempty"`
+		Mode     string     `json:"mode,omitempty"`
+		Env      []string   `json:"env,omitempty"`
+		Ports    []PortSpec `json:"ports,omitempty"`
+	} `json:"spec"`
+}
+
+// SecretSpec declares a Swarm secret.
+type SecretSpec struct {
+	TypeMeta
+	Metadata Metadata `json:"metadata"`
+	Spec     struct {
+		Host string `json:"host"`
+		Data string `json:"data"`
+	} `json:"spec"`
+}
+
+// ConfigSpec declares a Swarm config.
+type ConfigSpec struct {
+	TypeMeta
+	Metadata Metadata `json:"metadata"`
+	Spec     struct {
+		Host string `json:"host"`
+		Data string `json:"data"`
+	} `json:"spec"`
+}
+
+// StackSpec declares a bundle of services, secrets, and configs that are
+// applied and tracked together under a single stack namespace.
+type StackSpec struct {
+	TypeMeta
+	Metadata Metadata `json:"metadata"`
+	Spec     struct {
+		Host     string        `json:"host"`
+		Services []ServiceSpec `json:"services,omitempty"`
+		Secrets  []SecretSpec  `json:"secrets,omitempty"`
+		Configs  []ConfigSpec  `json:"configs,omitempty"`
+	} `json:"spec"`
+}
+
+// Known kind discriminators.
+const (
+	KindHost    = "Host"
+	KindService = "Service"
+	KindSecret  = "Secret"
+	KindConfig  = "Config"
+	KindStack   = "Stack"
+)
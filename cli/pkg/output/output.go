@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -17,20 +16,6 @@ type Printer interface {
 	Print(data interface{}) error
 }
 
-// NewPrinter creates a printer based on the format
-func NewPrinter(format string) Printer {
-	switch strings.ToLower(format) {
-	case "json":
-		return &JSONPrinter{}
-	case "yaml":
-		return &YAMLPrinter{}
-	case "wide":
-		return &TablePrinter{Wide: true}
-	default:
-		return &TablePrinter{Wide: false}
-	}
-}
-
 // JSONPrinter outputs data as JSON
 type JSONPrinter struct{}
 
@@ -47,19 +32,12 @@ func (p *YAMLPrinter) Print(data interface{}) error {
 	return yaml.NewEncoder(os.Stdout).Encode(data)
 }
 
-// TablePrinter outputs data as a table
+// TablePrinter outputs data as a table, deriving its columns from the
+// `table` struct tags on the data's element type. See table.go.
 type TablePrinter struct {
 	Wide bool
 }
 
-func (p *TablePrinter) Print(data interface{}) error {
-	// This is a simplified implementation
-	// In a real implementation, we'd use reflection or type switches
-	// to handle different data types appropriately
-	fmt.Printf("%+v\n", data)
-	return nil
-}
-
 // PrintTable prints data in table format
 func PrintTable(headers []string, rows [][]string) {
 	table := tablewriter.NewWriter(os.Stdout)
@@ -0,0 +1,273 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// marshalJSONCompact is used by the "json" template helper func.
+func marshalJSONCompact(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// formatAgo is the "ago" template helper func; it reuses FormatTimestamp for
+// time.Time values and falls back to the value's default string form.
+func formatAgo(v interface{}) string {
+	if t, ok := v.(time.Time); ok {
+		return FormatTimestamp(t)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// NewPrinter creates a printer from an --output/-o format string. In
+// addition to the plain "table", "wide", "json", and "yaml" formats, it
+// understands the parameterized forms used by kubectl/Docker CLI tooling:
+//
+//	-o name
+//	-o jsonpath={.items[*].name}
+//	-o go-template={{.Name}}
+//	-o go-template-file=/path/to.tmpl
+//	-o custom-columns=NAME:.name,IMAGE:.image
+func NewPrinter(format string) Printer {
+	kind, param := splitFormat(format)
+
+	switch strings.ToLower(kind) {
+	case "json":
+		return &JSONPrinter{}
+	case "yaml":
+		return &YAMLPrinter{}
+	case "wide":
+		return &TablePrinter{Wide: true}
+	case "name":
+		return &NamePrinter{}
+	case "jsonpath":
+		return newTemplatePrinter(jsonPathToTemplate(param))
+	case "go-template":
+		return newTemplatePrinter(param)
+	case "go-template-file":
+		data, err := os.ReadFile(param)
+		if err != nil {
+			return &errorPrinter{err: fmt.Errorf("failed to read template file %s: %w", param, err)}
+		}
+		return newTemplatePrinter(string(data))
+	case "custom-columns":
+		return newCustomColumnsPrinter(param)
+	default:
+		return &TablePrinter{Wide: false}
+	}
+}
+
+// IsTableFormat reports whether format renders through PrintTable directly
+// rather than through a Printer returned by NewPrinter. Callers that build
+// resource-specific table rows (see cmd/plugin.go and cmd/stack.go) use this to decide whether
+// to take that fast path or hand the raw data to NewPrinter instead.
+func IsTableFormat(format string) bool {
+	switch strings.ToLower(format) {
+	case "", "table", "wide":
+		return true
+	default:
+		return false
+	}
+}
+
+// splitFormat separates a format string like "jsonpath={.name}" into its
+// kind ("jsonpath") and parameter ("{.name}"). Formats with no "=" (table,
+// wide, json, yaml, name) return an empty parameter.
+func splitFormat(format string) (kind, param string) {
+	if idx := strings.Index(format, "="); idx >= 0 {
+		return format[:idx], format[idx+1:]
+	}
+	return format, ""
+}
+
+// errorPrinter defers a construction-time error (e.g. a missing template
+// file) until Print is called, so NewPrinter keeps its simple signature.
+type errorPrinter struct{ err error }
+
+func (p *errorPrinter) Print(data interface{}) error { return p.err }
+
+// NamePrinter prints just the resource name, one per line, matching
+// `kubectl get ... -o name`.
+type NamePrinter struct{}
+
+func (p *NamePrinter) Print(data interface{}) error {
+	return forEachItem(data, func(item reflect.Value) error {
+		name, ok := fieldByTag(item, "name")
+		if !ok {
+			name, ok = fieldByTag(item, "display_name")
+		}
+		if !ok {
+			return fmt.Errorf("value has no name field to print")
+		}
+		fmt.Println(name)
+		return nil
+	})
+}
+
+var templateFuncs = template.FuncMap{
+	"json":     jsonString,
+	"upper":    strings.ToUpper,
+	"truncate": TruncateID,
+	"ago":      formatAgo,
+}
+
+func jsonString(v interface{}) (string, error) {
+	data, err := marshalJSONCompact(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// newTemplatePrinter compiles text into a Printer, deferring any parse error
+// until Print is called so NewPrinter keeps a simple signature.
+func newTemplatePrinter(text string) Printer {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return &errorPrinter{err: fmt.Errorf("invalid template: %w", err)}
+	}
+	return &TemplatePrinter{tmpl: tmpl}
+}
+
+// TemplatePrinter renders data through a compiled Go text/template, once per
+// item for a slice and once for a single struct.
+type TemplatePrinter struct {
+	tmpl *template.Template
+}
+
+func (p *TemplatePrinter) Print(data interface{}) error {
+	return forEachItem(data, func(item reflect.Value) error {
+		if err := p.tmpl.Execute(os.Stdout, item.Interface()); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+		fmt.Println()
+		return nil
+	})
+}
+
+// jsonPathPattern matches a single {.field} or {.items[*].field} projection,
+// the common case used by this CLI's get commands.
+var jsonPathPattern = regexp.MustCompile(`^\{\s*\.(?:items\[\*\]\.)?([A-Za-z0-9_.]+)\s*\}$`)
+
+// jsonPathToTemplate translates the small JSONPath subset this CLI supports
+// into an equivalent Go template expression.
+func jsonPathToTemplate(expr string) string {
+	m := jsonPathPattern.FindStringSubmatch(strings.TrimSpace(expr))
+	if m == nil {
+		// Fall through and let the template parser report the syntax error.
+		return expr
+	}
+	field := m[1]
+	return fmt.Sprintf("{{.%s}}", toExportedField(field))
+}
+
+// toExportedField converts a dotted jsonpath/snake_case field reference
+// (e.g. "spec.image") into the dotted Go-exported field path used across
+// this CLI's API models (e.g. "Spec.Image"). Each "."-separated segment is
+// capitalized independently of the others, after its own "_"-parts are
+// capitalized and joined.
+func toExportedField(field string) string {
+	segments := strings.Split(field, ".")
+	for i, segment := range segments {
+		segments[i] = exportedSegment(segment)
+	}
+	return strings.Join(segments, ".")
+}
+
+// exportedSegment capitalizes a single snake_case path segment's parts and
+// joins them, e.g. "display_name" -> "DisplayName".
+func exportedSegment(segment string) string {
+	parts := strings.Split(segment, "_")
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// CustomColumnsPrinter renders a header row followed by one row per item,
+// with each column's value resolved from a simple ".field" spec.
+type CustomColumnsPrinter struct {
+	headers []string
+	fields  []string
+}
+
+func newCustomColumnsPrinter(spec string) Printer {
+	var headers, fields []string
+	for _, col := range strings.Split(spec, ",") {
+		parts := strings.SplitN(col, ":", 2)
+		if len(parts) != 2 {
+			return &errorPrinter{err: fmt.Errorf("invalid custom-columns spec %q: expected NAME:.field", col)}
+		}
+		headers = append(headers, parts[0])
+		fields = append(fields, strings.TrimPrefix(parts[1], "."))
+	}
+	return &CustomColumnsPrinter{headers: headers, fields: fields}
+}
+
+func (p *CustomColumnsPrinter) Print(data interface{}) error {
+	var rows [][]string
+	err := forEachItem(data, func(item reflect.Value) error {
+		row := make([]string, len(p.fields))
+		for i, field := range p.fields {
+			val, _ := fieldByTag(item, strings.ToLower(field))
+			row[i] = val
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	PrintTable(p.headers, rows)
+	return nil
+}
+
+// forEachItem calls fn for each element of data if it is a slice/array, or
+// once for data itself otherwise, dereferencing pointers along the way.
+func forEachItem(data interface{}, fn func(reflect.Value) error) error {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		for i := 0; i < v.Len(); i++ {
+			if err := fn(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fn(v)
+}
+
+// fieldByTag looks up a struct field by its `json` tag name (before any
+// comma option), falling back to a case-insensitive field name match.
+func fieldByTag(v reflect.Value, tag string) (string, bool) {
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		jsonTag := strings.Split(f.Tag.Get("json"), ",")[0]
+		if jsonTag == tag || strings.EqualFold(f.Name, tag) {
+			return fmt.Sprintf("%v", v.Field(i).Interface()), true
+		}
+	}
+	return "", false
+}
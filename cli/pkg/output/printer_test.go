@@ -0,0 +1,43 @@
+package output
+
+import "testing"
+
+func TestToExportedField(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "name", want: "Name"},
+		{field: "display_name", want: "DisplayName"},
+		{field: "spec.image", want: "Spec.Image"},
+		{field: "spec.display_name", want: "Spec.DisplayName"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := toExportedField(tt.field); got != tt.want {
+				t.Errorf("toExportedField(%q) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONPathToTemplate(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "{.name}", want: "{{.Name}}"},
+		{expr: "{.display_name}", want: "{{.DisplayName}}"},
+		{expr: "{.spec.image}", want: "{{.Spec.Image}}"},
+		{expr: "{.items[*].name}", want: "{{.Name}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			if got := jsonPathToTemplate(tt.expr); got != tt.want {
+				t.Errorf("jsonPathToTemplate(%q) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
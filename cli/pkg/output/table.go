@@ -0,0 +1,160 @@
+package output
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// columnOptions is the parsed form of a `table:"HEADER,opt,opt=val"` struct
+// tag.
+type columnOptions struct {
+	header    string
+	wide      bool
+	truncate  int
+	timestamp bool
+	boolean   bool
+	omitempty bool
+}
+
+// tableColumn pairs a struct field's index path with its column options.
+type tableColumn struct {
+	index []int
+	columnOptions
+}
+
+// parseTableTag parses a `table` struct tag's value. ok is false for
+// untagged or explicitly excluded ("-") fields.
+func parseTableTag(tag string) (opts columnOptions, ok bool) {
+	if tag == "" || tag == "-" {
+		return columnOptions{}, false
+	}
+
+	parts := strings.Split(tag, ",")
+	opts.header = parts[0]
+	for _, opt := range parts[1:] {
+		switch {
+		case opt == "wide":
+			opts.wide = true
+		case opt == "timestamp":
+			opts.timestamp = true
+		case opt == "bool":
+			opts.boolean = true
+		case opt == "omitempty":
+			opts.omitempty = true
+		case strings.HasPrefix(opt, "truncate="):
+			opts.truncate, _ = strconv.Atoi(strings.TrimPrefix(opt, "truncate="))
+		}
+	}
+	return opts, true
+}
+
+// tableColumns returns t's table-tagged fields, in declaration order.
+// wide-only columns are included only when wide is true, matching
+// `--format wide` showing extra columns the default table omits.
+func tableColumns(t reflect.Type, wide bool) []tableColumn {
+	var cols []tableColumn
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		opts, ok := parseTableTag(f.Tag.Get("table"))
+		if !ok || (opts.wide && !wide) {
+			continue
+		}
+		cols = append(cols, tableColumn{index: f.Index, columnOptions: opts})
+	}
+	return cols
+}
+
+// formatCell renders a single field value per its column options.
+func formatCell(v reflect.Value, opts columnOptions) string {
+	if opts.omitempty && v.IsZero() {
+		return "-"
+	}
+
+	if opts.timestamp {
+		if t, ok := v.Interface().(time.Time); ok {
+			return FormatTimestamp(t)
+		}
+	}
+	if opts.boolean {
+		if b, ok := v.Interface().(bool); ok {
+			return FormatBool(b)
+		}
+	}
+
+	s := fmt.Sprintf("%v", v.Interface())
+	if opts.truncate > 0 {
+		s = TruncateID(s, opts.truncate)
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// tableElemType resolves the struct type rows should be rendered from:
+// either data's own type, or its slice/array element type.
+func tableElemType(data interface{}) (reflect.Type, bool) {
+	t := reflect.TypeOf(data)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return nil, false
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	return t, true
+}
+
+// Print renders data as a table, deriving columns from each field's
+// `table:"HEADER,options"` tag. Supported options: wide (only shown when
+// Wide is set), truncate=N, timestamp, bool, and omitempty. Data that isn't
+// a struct or slice of structs (and so has no table tags to read) falls
+// back to a plain default representation.
+func (p *TablePrinter) Print(data interface{}) error {
+	elemType, ok := tableElemType(data)
+	if !ok {
+		fmt.Printf("%+v\n", data)
+		return nil
+	}
+
+	cols := tableColumns(elemType, p.Wide)
+	if len(cols) == 0 {
+		fmt.Printf("%+v\n", data)
+		return nil
+	}
+
+	headers := make([]string, len(cols))
+	for i, c := range cols {
+		headers[i] = c.header
+	}
+
+	var rows [][]string
+	err := forEachItem(data, func(item reflect.Value) error {
+		for item.Kind() == reflect.Ptr {
+			item = item.Elem()
+		}
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			row[i] = formatCell(item.FieldByIndex(c.index), c.columnOptions)
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	PrintTable(headers, rows)
+	return nil
+}
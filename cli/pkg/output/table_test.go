@@ -0,0 +1,88 @@
+package output
+
+import (
+	"testing"
+)
+
+func TestParseTableTag(t *testing.T) {
+	tests := []struct {
+		tag     string
+		wantOK  bool
+		wantOpt columnOptions
+	}{
+		{tag: "", wantOK: false},
+		{tag: "-", wantOK: false},
+		{tag: "NAME", wantOK: true, wantOpt: columnOptions{header: "NAME"}},
+		{
+			tag:     "ID,truncate=12",
+			wantOK:  true,
+			wantOpt: columnOptions{header: "ID", truncate: 12},
+		},
+		{
+			tag:     "ROLE,wide",
+			wantOK:  true,
+			wantOpt: columnOptions{header: "ROLE", wide: true},
+		},
+		{
+			tag:     "CREATED,timestamp",
+			wantOK:  true,
+			wantOpt: columnOptions{header: "CREATED", timestamp: true},
+		},
+		{
+			tag:     "ACTIVE,bool",
+			wantOK:  true,
+			wantOpt: columnOptions{header: "ACTIVE", boolean: true},
+		},
+		{
+			tag:     "MANAGER,omitempty",
+			wantOK:  true,
+			wantOpt: columnOptions{header: "MANAGER", omitempty: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			opts, ok := parseTableTag(tt.tag)
+			if ok != tt.wantOK {
+				t.Fatalf("parseTableTag(%q) ok = %v, want %v", tt.tag, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if opts != tt.wantOpt {
+				t.Errorf("parseTableTag(%q) = %+v, want %+v", tt.tag, opts, tt.wantOpt)
+			}
+		})
+	}
+}
+
+type testRow struct {
+	ID    string `table:"ID,truncate=4"`
+	Name  string `table:"NAME"`
+	Extra string `table:"EXTRA,wide"`
+	Skip  string `table:"-"`
+	Plain string
+}
+
+func TestTableColumns(t *testing.T) {
+	typ, ok := tableElemType([]testRow{})
+	if !ok {
+		t.Fatal("tableElemType([]testRow{}): not a struct/slice-of-struct")
+	}
+
+	narrow := tableColumns(typ, false)
+	if len(narrow) != 2 {
+		t.Fatalf("narrow columns = %d, want 2", len(narrow))
+	}
+	if narrow[0].header != "ID" || narrow[1].header != "NAME" {
+		t.Errorf("narrow headers = %q, %q", narrow[0].header, narrow[1].header)
+	}
+
+	wide := tableColumns(typ, true)
+	if len(wide) != 3 {
+		t.Fatalf("wide columns = %d, want 3", len(wide))
+	}
+	if wide[2].header != "EXTRA" {
+		t.Errorf("wide[2].header = %q, want EXTRA", wide[2].header)
+	}
+}